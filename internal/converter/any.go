@@ -0,0 +1,106 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/iancoleman/orderedmap"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// The property holding the type URL of an Any's embedded message:
+const anyTypeProperty = "@type"
+
+// A message which an Any could be carrying:
+type anyType struct {
+	fullName string
+	msg      *descriptor.DescriptorProto
+	pkg      *ProtoPackage
+}
+
+// Remembers a message (and its nested messages) as something an Any could be carrying:
+func (c *Converter) registerAnyType(pkg *ProtoPackage, parentName string, msg *descriptor.DescriptorProto) {
+	fullName := msg.GetName()
+	if parentName != "" {
+		fullName = parentName + "." + fullName
+	}
+
+	// Map entries are just an implementation detail of maps, and WKTs embed themselves differently:
+	if !msg.GetOptions().GetMapEntry() && !strings.HasPrefix(fullName, "google.protobuf.") {
+		c.anyTypes = append(c.anyTypes, anyType{fullName: fullName, msg: msg, pkg: pkg})
+	}
+	for _, nested := range msg.GetNestedType() {
+		c.registerAnyType(pkg, fullName, nested)
+	}
+}
+
+// Converts a google.protobuf.Any into an object with a "@type", plus the fields of the embedded message:
+func (c *Converter) convertAny() (*jsonschema.Type, error) {
+	if !c.ResolveAnyTypes || len(c.anyTypes) == 0 {
+		return c.anyJSONSchemaType(), nil
+	}
+
+	// The union of known types lives in the shared definitions (its members may well contain Anys themselves):
+	definitionName := "google.protobuf.Any"
+	ref := &jsonschema.Type{Ref: c.definitionsRefPrefix() + definitionName}
+	if _, ok := c.definitions[definitionName]; ok {
+		return ref, nil
+	}
+	definition := c.anyJSONSchemaType()
+	c.definitions[definitionName] = definition
+
+	// One member per known type, selected by the end of its type URL:
+	var typePatterns []string
+	for _, anyType := range c.anyTypes {
+		typePattern := "/" + regexp.QuoteMeta(anyType.fullName) + "$"
+		typePatterns = append(typePatterns, typePattern)
+
+		c.logger.WithField("type_name", anyType.fullName).Debug("Adding a known type to Any")
+		messageJSONSchemaType, err := c.convertMessageType(anyType.pkg, anyType.msg, anyType.pkg.name)
+		if err != nil {
+			return nil, err
+		}
+		messageJSONSchemaType.Version = ""
+
+		// The type URL is just another property of the embedded message:
+		properties := orderedmap.New()
+		properties.Set(anyTypeProperty, &jsonschema.Type{Pattern: typePattern})
+		if messageJSONSchemaType.Properties != nil {
+			for _, name := range messageJSONSchemaType.Properties.Keys() {
+				property, _ := messageJSONSchemaType.Properties.Get(name)
+				properties.Set(name, property)
+			}
+		}
+		messageJSONSchemaType.Properties = properties
+		messageJSONSchemaType.Required = append([]string{anyTypeProperty}, messageJSONSchemaType.Required...)
+
+		definition.OneOf = append(definition.OneOf, messageJSONSchemaType)
+	}
+
+	// Types we don't know about are still allowed through:
+	unknownTypeProperties := orderedmap.New()
+	unknownTypeProperties.Set(anyTypeProperty, &jsonschema.Type{Pattern: "(" + strings.Join(typePatterns, ")|(") + ")"})
+	definition.OneOf = append(definition.OneOf, &jsonschema.Type{
+		Not: &jsonschema.Type{Properties: unknownTypeProperties},
+	})
+
+	return ref, nil
+}
+
+// An Any which could be carrying anything:
+func (c *Converter) anyJSONSchemaType() *jsonschema.Type {
+	properties := orderedmap.New()
+	properties.Set(anyTypeProperty, &jsonschema.Type{
+		Type:        gojsonschema.TYPE_STRING,
+		Description: "A URL identifying the type of the embedded message (eg \"type.googleapis.com/package.MessageName\")",
+	})
+
+	return &jsonschema.Type{
+		Type:                 gojsonschema.TYPE_OBJECT,
+		Properties:           properties,
+		Required:             []string{anyTypeProperty},
+		AdditionalProperties: []byte("true"),
+	}
+}
@@ -13,22 +13,66 @@ import (
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 	"github.com/sirupsen/logrus"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Ways of naming the generated JSON-Schema files:
+const (
+	FileNamingName        = "name"         // <MessageName>.jsonschema (default)
+	FileNamingFullName    = "full_name"    // <package>.<MessageName>.jsonschema
+	FileNamingPackageDirs = "package_dirs" // <package>/<MessageName>.jsonschema (with one directory per package component)
+)
+
+// Ways of encoding ENUM values:
+const (
+	EnumsAsBoth    = "both"    // Names or numbers (default)
+	EnumsAsNames   = "names"   // Names only
+	EnumsAsNumbers = "numbers" // Numbers only
+)
+
+// Kinds of output:
+const (
+	OutputJSONSchema  = "jsonschema"   // One JSON-Schema file per type (default)
+	OutputOpenAPI     = "openapi"      // One OpenAPI document (YAML) with every type in its "components.schemas"
+	OutputOpenAPIJSON = "openapi_json" // The same OpenAPI document as JSON
 )
 
 // Converter is everything you need to convert protos to JSONSchemas:
 type Converter struct {
-	AllowNullValues              bool
-	DisallowAdditionalProperties bool
-	DisallowBigIntsAsStrings     bool
-	UseProtoAndJSONFieldnames    bool
-	logger                       *logrus.Logger
-	sourceInfo                   *sourceCodeInfo
+	AllowNullValues               bool
+	DisallowAdditionalProperties  bool
+	DisallowBigIntsAsStrings      bool
+	Draft                         string
+	EnforceFloat32Range           bool
+	EnumOneOf                     bool
+	EnumsAs                       string
+	ExcludeDeprecated             bool
+	FieldBehaviorVariants         bool
+	FileNaming                    string
+	GenerateAllTypes              bool
+	GenerateMethods               bool
+	Output                        string
+	ProtojsonCompat               bool
+	RequireImplicitPresenceFields bool
+	ResolveAnyTypes               bool
+	UseProtoAndJSONFieldnames     bool
+	UseRefs                       bool
+	anyTypes                      []anyType
+	definitions                   jsonschema.Definitions
+	fieldBehaviorVariant          string
+	fileNames                     map[string]string
+	messageFiles                  map[*descriptor.DescriptorProto]*descriptor.FileDescriptorProto
+	messagesInProgress            map[*descriptor.DescriptorProto]bool
+	logger                        *logrus.Logger
+	options                       *protoOptions
+	sourceInfo                    *sourceCodeInfo
 }
 
 // New returns a configured *Converter:
 func New(logger *logrus.Logger) *Converter {
 	return &Converter{
-		logger: logger,
+		logger:             logger,
+		messagesInProgress: make(map[*descriptor.DescriptorProto]bool),
 	}
 }
 
@@ -48,16 +92,26 @@ func (c *Converter) ConvertFrom(rd io.Reader) (*plugin.CodeGeneratorResponse, er
 		return nil, err
 	}
 
-	c.parseGeneratorParameters(req.GetParameter())
+	if err := c.parseGeneratorParameters(req.GetParameter()); err != nil {
+		c.logger.WithError(err).Error("Invalid parameters")
+		return &plugin.CodeGeneratorResponse{Error: proto.String(err.Error())}, err
+	}
 
 	c.logger.Debug("Converting input")
 	return c.convert(req)
 	// return c.debugger(req)
 }
 
-func (c *Converter) parseGeneratorParameters(parameters string) {
+func (c *Converter) parseGeneratorParameters(parameters string) error {
 	for _, parameter := range strings.Split(parameters, ",") {
-		switch parameter {
+
+		// Some parameters take a value ("name=value"):
+		name, value := parameter, ""
+		if i := strings.Index(parameter, "="); i >= 0 {
+			name, value = parameter[:i], parameter[i+1:]
+		}
+
+		switch name {
 		case "allow_null_values":
 			c.AllowNullValues = true
 		case "debug":
@@ -66,10 +120,57 @@ func (c *Converter) parseGeneratorParameters(parameters string) {
 			c.DisallowAdditionalProperties = true
 		case "disallow_bigints_as_strings":
 			c.DisallowBigIntsAsStrings = true
+		case "draft":
+			if _, ok := draftSchemaURIs[value]; !ok {
+				return fmt.Errorf("unknown draft %q (expected one of %s)", value, strings.Join(drafts, ", "))
+			}
+			c.Draft = value
+		case "enforce_float32_range":
+			c.EnforceFloat32Range = true
+		case "enum_oneof":
+			c.EnumOneOf = true
+		case "enums_as":
+			switch value {
+			case EnumsAsBoth, EnumsAsNames, EnumsAsNumbers:
+				c.EnumsAs = value
+			default:
+				return fmt.Errorf("unknown enums_as %q (expected %s, %s or %s)", value, EnumsAsNames, EnumsAsNumbers, EnumsAsBoth)
+			}
+		case "exclude_deprecated":
+			c.ExcludeDeprecated = true
+		case "field_behavior_variants":
+			c.FieldBehaviorVariants = true
+		case "file_naming":
+			switch value {
+			case FileNamingName, FileNamingFullName, FileNamingPackageDirs:
+				c.FileNaming = value
+			default:
+				return fmt.Errorf("unknown file_naming %q (expected %s, %s or %s)", value, FileNamingName, FileNamingFullName, FileNamingPackageDirs)
+			}
+		case "generate_all_types":
+			c.GenerateAllTypes = true
+		case "generate_methods":
+			c.GenerateMethods = true
+		case "output":
+			switch value {
+			case OutputJSONSchema, OutputOpenAPI, OutputOpenAPIJSON:
+				c.Output = value
+			default:
+				return fmt.Errorf("unknown output %q (expected %s, %s or %s)", value, OutputJSONSchema, OutputOpenAPI, OutputOpenAPIJSON)
+			}
 		case "proto_and_json_fieldnames":
 			c.UseProtoAndJSONFieldnames = true
+		case "protojson_compat":
+			c.ProtojsonCompat = true
+		case "require_implicit_presence_fields":
+			c.RequireImplicitPresenceFields = true
+		case "resolve_any_types":
+			c.ResolveAnyTypes = true
+		case "use_refs":
+			c.UseRefs = true
 		}
 	}
+	return c.checkOpenAPIParameters()
 }
 
 // Converts a proto "ENUM" into a JSON-Schema:
@@ -77,7 +178,7 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto) (jsons
 
 	// Prepare a new jsonschema.Type for our eventual return value:
 	jsonSchemaType := jsonschema.Type{
-		Version: jsonschema.Version,
+		Version: c.schemaVersion(),
 	}
 
 	// Generate a description from src comments (if available)
@@ -85,19 +186,100 @@ func (c *Converter) convertEnumType(enum *descriptor.EnumDescriptorProto) (jsons
 		jsonSchemaType.Description = formatDescription(src)
 	}
 
-	// Allow both strings and integers:
-	jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: "string"})
-	jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: "integer"})
+	setDeprecated(&jsonSchemaType, enum.GetOptions().GetDeprecated())
 
-	// Add the allowed values:
-	for _, enumValue := range enum.Value {
-		jsonSchemaType.Enum = append(jsonSchemaType.Enum, enumValue.Name)
-		jsonSchemaType.Enum = append(jsonSchemaType.Enum, enumValue.Number)
+	// Apply any overrides from the enum's options:
+	if err := applyEnumOptions(enum, &jsonSchemaType); err != nil {
+		return jsonSchemaType, err
 	}
 
+	// Add the allowed values:
+	c.setEnumValues(&jsonSchemaType, enum, false)
+
 	return jsonSchemaType, nil
 }
 
+// Allows the values of an ENUM, as names and/or numbers (and optionally NULL):
+func (c *Converter) setEnumValues(jsonSchemaType *jsonschema.Type, enum *descriptor.EnumDescriptorProto, nullable bool) {
+	if c.EnumOneOf {
+		c.setEnumValueBranches(jsonSchemaType, enum, nullable)
+		return
+	}
+
+	var jsonTypes []string
+	if c.EnumsAs != EnumsAsNumbers {
+		jsonTypes = append(jsonTypes, gojsonschema.TYPE_STRING)
+	}
+	if c.EnumsAs != EnumsAsNames {
+		jsonTypes = append(jsonTypes, gojsonschema.TYPE_INTEGER)
+	}
+	if nullable {
+		jsonTypes = append(jsonTypes, gojsonschema.TYPE_NULL)
+	}
+	c.setTypes(jsonSchemaType, jsonTypes...)
+
+	// Deprecated values are listed in "x-deprecated" (there are no branches to mark them in):
+	var deprecatedValues []interface{}
+	for _, enumValue := range c.enumValues(enum) {
+		var values []interface{}
+		if c.EnumsAs != EnumsAsNumbers {
+			values = append(values, enumValue.GetName())
+		}
+		if c.EnumsAs != EnumsAsNames {
+			values = append(values, enumValue.GetNumber())
+		}
+		jsonSchemaType.Enum = append(jsonSchemaType.Enum, values...)
+		if enumValue.GetOptions().GetDeprecated() {
+			deprecatedValues = append(deprecatedValues, values...)
+		}
+	}
+	if nullable {
+		jsonSchemaType.Enum = append(jsonSchemaType.Enum, nil)
+	}
+	if len(deprecatedValues) > 0 {
+		setExtra(jsonSchemaType, "x-deprecated", deprecatedValues)
+	}
+}
+
+// Describes each value of an ENUM in a "oneOf" branch of its own (titled with its name, and described by its comments),
+// along with the "x-enumNames" and "x-enum-descriptions" which code generators look for next to the "enum":
+func (c *Converter) setEnumValueBranches(jsonSchemaType *jsonschema.Type, enum *descriptor.EnumDescriptorProto, nullable bool) {
+	var enumNames, enumDescriptions []string
+	for _, enumValue := range c.enumValues(enum) {
+		var values []interface{}
+		if c.EnumsAs != EnumsAsNumbers {
+			values = append(values, enumValue.GetName())
+		}
+		if c.EnumsAs != EnumsAsNames {
+			values = append(values, enumValue.GetNumber())
+		}
+
+		branch := &jsonschema.Type{Title: enumValue.GetName()}
+		if src := c.sourceInfo.GetEnumValue(enumValue); src != nil {
+			branch.Description = formatDescription(src)
+		}
+		setDeprecated(branch, enumValue.GetOptions().GetDeprecated())
+		c.setConst(branch, values...)
+		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, branch)
+
+		for _, value := range values {
+			jsonSchemaType.Enum = append(jsonSchemaType.Enum, value)
+			enumNames = append(enumNames, enumValue.GetName())
+			enumDescriptions = append(enumDescriptions, branch.Description)
+		}
+	}
+
+	if nullable {
+		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_NULL})
+		jsonSchemaType.Enum = append(jsonSchemaType.Enum, nil)
+		enumNames = append(enumNames, gojsonschema.TYPE_NULL)
+		enumDescriptions = append(enumDescriptions, "")
+	}
+
+	setExtra(jsonSchemaType, "x-enumNames", enumNames)
+	setExtra(jsonSchemaType, "x-enum-descriptions", enumDescriptions)
+}
+
 // Converts a proto file into a JSON-Schema:
 func (c *Converter) convertFile(file *descriptor.FileDescriptorProto) ([]*plugin.CodeGeneratorResponse_File, error) {
 
@@ -107,6 +289,12 @@ func (c *Converter) convertFile(file *descriptor.FileDescriptorProto) ([]*plugin
 	// Prepare a list of responses:
 	response := []*plugin.CodeGeneratorResponse_File{}
 
+	// Files can opt out of generation altogether:
+	if getFileOptions(file).GetIgnore() {
+		c.logger.WithField("proto_filename", protoFileName).Info("Ignoring file")
+		return response, nil
+	}
+
 	// Warn about multiple messages / enums in files:
 	if len(file.GetMessageType()) > 1 {
 		c.logger.WithField("schemas", len(file.GetMessageType())).WithField("proto_filename", protoFileName).Warn("protoc-gen-jsonschema will create multiple MESSAGE schemas from one proto file")
@@ -115,83 +303,258 @@ func (c *Converter) convertFile(file *descriptor.FileDescriptorProto) ([]*plugin
 		c.logger.WithField("schemas", len(file.GetMessageType())).WithField("proto_filename", protoFileName).Warn("protoc-gen-jsonschema will create multiple ENUM schemas from one proto file")
 	}
 
-	// Generate standalone ENUMs:
-	if len(file.GetMessageType()) == 0 {
+	// Generate standalone ENUMs (when there are no messages, or when we're generating schemas for every type):
+	if len(file.GetMessageType()) == 0 || c.GenerateAllTypes {
 		for _, enum := range file.GetEnumType() {
-			jsonSchemaFileName := fmt.Sprintf("%s.jsonschema", enum.GetName())
-			c.logger.WithField("proto_filename", protoFileName).WithField("enum_name", enum.GetName()).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for stand-alone ENUM")
-
-			// Convert the ENUM:
-			enumJSONSchema, err := c.convertEnumType(enum)
-			if err != nil {
-				c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
-				return nil, err
+			if getEnumOptions(enum).GetIgnore() {
+				continue
 			}
-
-			// Marshal the JSON-Schema into JSON:
-			jsonSchemaJSON, err := json.MarshalIndent(enumJSONSchema, "", "    ")
+			resFile, err := c.convertEnumFile(file, enum, enum.GetName())
 			if err != nil {
-				c.logger.WithError(err).Error("Failed to encode jsonSchema")
 				return nil, err
 			}
-
-			// Add a response:
-			resFile := &plugin.CodeGeneratorResponse_File{
-				Name:    proto.String(jsonSchemaFileName),
-				Content: proto.String(string(jsonSchemaJSON)),
-			}
 			response = append(response, resFile)
 		}
-	} else {
-		// Otherwise process MESSAGES (packages):
+	}
+
+	// Process MESSAGES (packages):
+	if len(file.GetMessageType()) > 0 {
 		pkg, ok := c.relativelyLookupPackage(globalPkg, file.GetPackage())
 		if !ok {
 			return nil, fmt.Errorf("no such package found: %s", file.GetPackage())
 		}
 		for _, msg := range file.GetMessageType() {
-			jsonSchemaFileName := fmt.Sprintf("%s.jsonschema", msg.GetName())
-			c.logger.WithField("proto_filename", protoFileName).WithField("msg_name", msg.GetName()).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for MESSAGE")
-
-			// Convert the message:
-			messageJSONSchema, err := c.convertMessageType(pkg, msg, "")
+			resFiles, err := c.convertMessageFiles(pkg, file, msg, msg.GetName())
 			if err != nil {
-				c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
 				return nil, err
 			}
+			response = append(response, resFiles...)
+		}
+	}
 
-			// Marshal the JSON-Schema into JSON:
-			jsonSchemaJSON, err := json.MarshalIndent(messageJSONSchema, "", "    ")
+	// Optionally process the methods of SERVICES:
+	if c.GenerateMethods {
+		pkg := c.registerPackage(file.Package)
+		for _, svc := range file.GetService() {
+			resFiles, err := c.convertServiceFiles(pkg, file, svc)
 			if err != nil {
-				c.logger.WithError(err).Error("Failed to encode jsonSchema")
 				return nil, err
 			}
+			response = append(response, resFiles...)
+		}
+	}
+
+	return response, nil
+}
+
+// Converts a stand-alone ENUM into a JSON-Schema file (typeName is its name qualified by any enclosing messages):
+func (c *Converter) convertEnumFile(file *descriptor.FileDescriptorProto, enum *descriptor.EnumDescriptorProto, typeName string) (*plugin.CodeGeneratorResponse_File, error) {
+	protoFileName := path.Base(file.GetName())
+
+	jsonSchemaFileName, err := c.schemaFileName(file.GetPackage(), typeName)
+	if err != nil {
+		return nil, err
+	}
+	c.logger.WithField("proto_filename", protoFileName).WithField("enum_name", typeName).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for stand-alone ENUM")
 
-			// Add a response:
-			resFile := &plugin.CodeGeneratorResponse_File{
-				Name:    proto.String(jsonSchemaFileName),
-				Content: proto.String(string(jsonSchemaJSON)),
+	// Convert the ENUM:
+	enumJSONSchema, err := c.convertEnumType(enum)
+	if err != nil {
+		c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
+		return nil, err
+	}
+
+	return c.schemaFile(jsonSchemaFileName, &enumJSONSchema)
+}
+
+// Converts a MESSAGE into a JSON-Schema file (typeName is its name qualified by any enclosing messages).
+// When generating schemas for every type this also converts its nested messages and enums:
+func (c *Converter) convertMessageFiles(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, msg *descriptor.DescriptorProto, typeName string) ([]*plugin.CodeGeneratorResponse_File, error) {
+	response := []*plugin.CodeGeneratorResponse_File{}
+
+	// Ignored messages don't get a schema of their own (but their nested types still might):
+	if !getMessageOptions(msg).GetIgnore() {
+		resFile, err := c.convertMessageFile(pkg, file, msg, typeName, "")
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, resFile)
+
+		// Optionally split the message into what clients send, and what servers return:
+		if c.FieldBehaviorVariants {
+			for _, variant := range []string{fieldBehaviorVariantInput, fieldBehaviorVariantOutput} {
+				resFile, err := c.convertMessageFile(pkg, file, msg, typeName, variant)
+				if err != nil {
+					return nil, err
+				}
+				response = append(response, resFile)
 			}
-			response = append(response, resFile)
 		}
 	}
 
+	if !c.GenerateAllTypes {
+		return response, nil
+	}
+
+	// Nested ENUMs:
+	for _, enum := range msg.GetEnumType() {
+		if getEnumOptions(enum).GetIgnore() {
+			continue
+		}
+		resFile, err := c.convertEnumFile(file, enum, typeName+"."+enum.GetName())
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, resFile)
+	}
+
+	// Nested MESSAGEs (map entries are just an implementation detail of maps):
+	for _, nested := range msg.GetNestedType() {
+		if nested.GetOptions().GetMapEntry() {
+			continue
+		}
+		resFiles, err := c.convertMessageFiles(pkg, file, nested, typeName+"."+nested.GetName())
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, resFiles...)
+	}
+
 	return response, nil
 }
 
+// Converts a MESSAGE (or one of its field behavior variants, "<Message>.input.jsonschema" and
+// "<Message>.output.jsonschema") into a JSON-Schema file:
+func (c *Converter) convertMessageFile(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, msg *descriptor.DescriptorProto, typeName, variant string) (*plugin.CodeGeneratorResponse_File, error) {
+	protoFileName := path.Base(file.GetName())
+
+	variantName := typeName
+	if variant != "" {
+		variantName = typeName + "." + variant
+	}
+	jsonSchemaFileName, err := c.schemaFileName(file.GetPackage(), variantName)
+	if err != nil {
+		return nil, err
+	}
+	c.fieldBehaviorVariant = variant
+	defer func() { c.fieldBehaviorVariant = "" }()
+	c.logger.WithField("proto_filename", protoFileName).WithField("msg_name", variantName).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for MESSAGE")
+
+	// Convert the message (collecting any definitions it refers to):
+	c.definitions = jsonschema.Definitions{}
+	messageJSONSchema, err := c.convertMessageType(pkg, msg, "")
+	if err != nil {
+		c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
+		return nil, err
+	}
+	if len(c.definitions) > 0 {
+		c.setDefinitions(messageJSONSchema, c.definitions)
+	}
+
+	return c.schemaFile(jsonSchemaFileName, messageJSONSchema)
+}
+
+// Marshals a JSON-Schema into a response file:
+func (c *Converter) schemaFile(jsonSchemaFileName string, jsonSchema interface{}) (*plugin.CodeGeneratorResponse_File, error) {
+
+	// Marshal the JSON-Schema into JSON:
+	jsonSchemaJSON, err := json.MarshalIndent(jsonSchema, "", "    ")
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to encode jsonSchema")
+		return nil, err
+	}
+
+	// Add a response:
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(jsonSchemaFileName),
+		Content: proto.String(string(jsonSchemaJSON)),
+	}, nil
+}
+
+// Works out the name of the JSON-Schema file for a type, making sure that no other type has been given the same name:
+func (c *Converter) schemaFileName(pkgName, typeName string) (string, error) {
+	fullName := typeName
+	if pkgName != "" {
+		fullName = pkgName + "." + typeName
+	}
+
+	var jsonSchemaFileName string
+	switch c.FileNaming {
+	case FileNamingFullName:
+		jsonSchemaFileName = fmt.Sprintf("%s.jsonschema", fullName)
+	case FileNamingPackageDirs:
+		jsonSchemaFileName = path.Join(strings.Replace(pkgName, ".", "/", -1), fmt.Sprintf("%s.jsonschema", typeName))
+	default:
+		jsonSchemaFileName = fmt.Sprintf("%s.jsonschema", typeName)
+	}
+
+	if existing, ok := c.fileNames[jsonSchemaFileName]; ok {
+		return "", fmt.Errorf("%s and %s would both be written to %s (try file_naming=%s or file_naming=%s)", existing, fullName, jsonSchemaFileName, FileNamingFullName, FileNamingPackageDirs)
+	}
+	c.fileNames[jsonSchemaFileName] = fullName
+
+	return jsonSchemaFileName, nil
+}
+
 func (c *Converter) convert(req *plugin.CodeGeneratorRequest) (*plugin.CodeGeneratorResponse, error) {
 	generateTargets := make(map[string]bool)
 	for _, file := range req.GetFileToGenerate() {
 		generateTargets[file] = true
 	}
+	generatePackages := make(map[string]bool)
+	for _, file := range req.GetProtoFile() {
+		if generateTargets[file.GetName()] {
+			generatePackages[file.GetPackage()] = true
+		}
+	}
 
 	c.sourceInfo = newSourceCodeInfo(req.GetProtoFile())
-	res := &plugin.CodeGeneratorResponse{}
+	c.messageFiles = make(map[*descriptor.DescriptorProto]*descriptor.FileDescriptorProto)
+	c.fileNames = make(map[string]string)
+	c.anyTypes = nil
+	options, err := newProtoOptions(req.GetProtoFile())
+	if err != nil {
+		c.logger.WithError(err).Warn("Unable to decode custom options (like validation rules)")
+	}
+	c.options = options
+	res := &plugin.CodeGeneratorResponse{
+		SupportedFeatures: proto.Uint64(uint64(plugin.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)),
+	}
 	for _, file := range req.GetProtoFile() {
 		for _, msg := range file.GetMessageType() {
 			c.logger.WithField("msg_name", msg.GetName()).WithField("package_name", file.GetPackage()).Debug("Loading a message")
 			c.registerType(file.Package, msg)
+			c.registerMessageFile(file, msg)
+
+			// Anys can only carry messages from the files we're generating (or from their packages), rather than
+			// from everything they happen to import:
+			if generateTargets[file.GetName()] || generatePackages[file.GetPackage()] {
+				c.registerAnyType(c.registerPackage(file.Package), file.GetPackage(), msg)
+			}
 		}
+		for _, enum := range file.GetEnumType() {
+			c.logger.WithField("enum_name", enum.GetName()).WithField("package_name", file.GetPackage()).Debug("Loading an enum")
+			c.registerEnum(file.Package, enum)
+		}
+	}
+
+	// OpenAPI documents describe all of the files together:
+	if c.generatesOpenAPI() {
+		var files []*descriptor.FileDescriptorProto
+		for _, file := range req.GetProtoFile() {
+			if _, ok := generateTargets[file.GetName()]; ok {
+				files = append(files, file)
+			}
+		}
+		converted, err := c.convertOpenAPIFile(files)
+		if err != nil {
+			res.Error = proto.String(fmt.Sprintf("Failed to convert %s: %v", strings.Join(req.GetFileToGenerate(), ", "), err))
+			return res, err
+		}
+		res.File = append(res.File, converted)
+		return res, nil
 	}
+
 	for _, file := range req.GetProtoFile() {
 		if _, ok := generateTargets[file.GetName()]; ok {
 			c.logger.WithField("filename", file.GetName()).Debug("Converting file")
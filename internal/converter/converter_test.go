@@ -14,19 +14,36 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/sirupsen/logrus"
 	"github.com/sixt/protoc-gen-jsonschema/internal/converter/testdata"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 var (
-	sampleProtoDirectory = "testdata/proto"
-	sampleProtos         = make(map[string]sampleProto)
+	optionsProtoDirectory = "../../options"
+	sampleProtoDirectory  = "testdata/proto"
+	sampleProtos          = make(map[string]sampleProto)
 )
 
 type sampleProto struct {
-	AllowNullValues           bool
-	ExpectedJSONSchema        []string
-	FilesToGenerate           []string
-	ProtoFileName             string
-	UseProtoAndJSONFieldNames bool
+	AllowNullValues               bool
+	Draft                         string
+	EnforceFloat32Range           bool
+	EnumOneOf                     bool
+	EnumsAs                       string
+	ExcludeDeprecated             bool
+	ExpectedFileNames             []string
+	ExpectedJSONSchema            []string
+	FieldBehaviorVariants         bool
+	FileNaming                    string
+	FilesToGenerate               []string
+	GenerateAllTypes              bool
+	GenerateMethods               bool
+	Output                        string
+	ProtoFileName                 string
+	ProtojsonCompat               bool
+	RequireImplicitPresenceFields bool
+	ResolveAnyTypes               bool
+	UseProtoAndJSONFieldNames     bool
+	UseRefs                       bool
 }
 
 func TestGenerateJsonSchema(t *testing.T) {
@@ -40,16 +57,56 @@ func TestGenerateJsonSchema(t *testing.T) {
 	testConvertSampleProto(t, sampleProtos["ArrayOfObjects"])
 	testConvertSampleProto(t, sampleProtos["ArrayOfPrimitives"])
 	testConvertSampleProto(t, sampleProtos["ArrayOfPrimitivesDouble"])
+	testConvertSampleProto(t, sampleProtos["Deprecated"])
+	testConvertSampleProto(t, sampleProtos["DeprecatedExcluded"])
+	testConvertSampleProto(t, sampleProtos["DeprecatedValues"])
 	testConvertSampleProto(t, sampleProtos["EnumCeption"])
+	testConvertSampleProto(t, sampleProtos["EnumCeptionRefs"])
+	testConvertSampleProto(t, sampleProtos["EnumCeptionOpenAPI"])
+	testConvertSampleProto(t, sampleProtos["EnumEncodingsNames"])
+	testConvertSampleProto(t, sampleProtos["EnumEncodingsNumbers"])
+	testConvertSampleProto(t, sampleProtos["EnumEncodingsOneOf"])
+	testConvertSampleProto(t, sampleProtos["EnumEncodingsOneOfNamesDraft07"])
+	testConvertSampleProto(t, sampleProtos["Envelope"])
+	testConvertSampleProto(t, sampleProtos["EnvelopeResolveAnyTypes"])
+	testConvertSampleProto(t, sampleProtos["FieldBehavior"])
+	testConvertSampleProto(t, sampleProtos["FieldBehaviorVariants"])
+	testConvertSampleProto(t, sampleProtos["Http"])
+	testConvertSampleProto(t, sampleProtos["HttpOptionalRefs"])
 	testConvertSampleProto(t, sampleProtos["ImportedEnum"])
+	testConvertSampleProto(t, sampleProtos["ImportedEnumDraft201909"])
 	testConvertSampleProto(t, sampleProtos["NestedMessage"])
+	testConvertSampleProto(t, sampleProtos["NestedMessageOpenAPIJSON"])
 	testConvertSampleProto(t, sampleProtos["NestedObject"])
+	testConvertSampleProto(t, sampleProtos["NestedObjectAllTypes"])
+	testConvertSampleProto(t, sampleProtos["OneOf"])
+	testConvertSampleProto(t, sampleProtos["OneOfDouble"])
+	testConvertSampleProto(t, sampleProtos["OtherPackageFullName"])
+	testConvertSampleProto(t, sampleProtos["OtherPackagePackageDirs"])
 	testConvertSampleProto(t, sampleProtos["PayloadMessage"])
+	testConvertSampleProto(t, sampleProtos["Presence"])
+	testConvertSampleProto(t, sampleProtos["PresenceNullValues"])
+	testConvertSampleProto(t, sampleProtos["Recursion"])
+	testConvertSampleProto(t, sampleProtos["RequiredFields"])
+	testConvertSampleProto(t, sampleProtos["RequiredFieldsDouble"])
+	testConvertSampleProto(t, sampleProtos["Scalars"])
+	testConvertSampleProto(t, sampleProtos["SchemaOptions"])
+	testConvertSampleProto(t, sampleProtos["SchemaOptionsGenerateAllTypes"])
+	testConvertSampleProto(t, sampleProtos["SchemaOptionsRefs"])
+	testConvertSampleProto(t, sampleProtos["ScalarsFloat32Range"])
+	testConvertSampleProto(t, sampleProtos["ScalarsProtojsonCompat"])
+	testConvertSampleProto(t, sampleProtos["Service"])
 	testConvertSampleProto(t, sampleProtos["SeveralEnums"])
 	testConvertSampleProto(t, sampleProtos["SeveralMessages"])
 	testConvertSampleProto(t, sampleProtos["ArrayOfEnums"])
 	testConvertSampleProto(t, sampleProtos["Maps"])
+	testConvertSampleProto(t, sampleProtos["MapsRefs"])
+	testConvertSampleProto(t, sampleProtos["MapsRefsDraft202012"])
 	testConvertSampleProto(t, sampleProtos["WellKnown"])
+	testConvertSampleProto(t, sampleProtos["WellKnownProtojsonCompat"])
+	testConvertSampleProto(t, sampleProtos["WellKnownTypeSystem"])
+	testConvertSampleProto(t, sampleProtos["Validate"])
+	testConvertSampleProto(t, sampleProtos["ValidateDraft202012"])
 }
 
 func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
@@ -62,7 +119,24 @@ func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
 	// Use the logger to make a Converter:
 	protoConverter := New(logger)
 	protoConverter.AllowNullValues = sampleProto.AllowNullValues
+	protoConverter.Draft = sampleProto.Draft
+	protoConverter.EnforceFloat32Range = sampleProto.EnforceFloat32Range
+	protoConverter.EnumOneOf = sampleProto.EnumOneOf
+	protoConverter.EnumsAs = sampleProto.EnumsAs
+	protoConverter.ExcludeDeprecated = sampleProto.ExcludeDeprecated
+	protoConverter.FieldBehaviorVariants = sampleProto.FieldBehaviorVariants
+	protoConverter.FileNaming = sampleProto.FileNaming
+	protoConverter.GenerateAllTypes = sampleProto.GenerateAllTypes
+	protoConverter.GenerateMethods = sampleProto.GenerateMethods
+	protoConverter.Output = sampleProto.Output
+	protoConverter.ProtojsonCompat = sampleProto.ProtojsonCompat
+	protoConverter.RequireImplicitPresenceFields = sampleProto.RequireImplicitPresenceFields
+	protoConverter.ResolveAnyTypes = sampleProto.ResolveAnyTypes
 	protoConverter.UseProtoAndJSONFieldnames = sampleProto.UseProtoAndJSONFieldNames
+	protoConverter.UseRefs = sampleProto.UseRefs
+	if err := protoConverter.checkOpenAPIParameters(); err != nil {
+		t.Fatal(err)
+	}
 
 	// Open the sample proto file:
 	sampleProtoFileName := fmt.Sprintf("%v/%v", sampleProtoDirectory, sampleProto.ProtoFileName)
@@ -85,6 +159,10 @@ func testConvertSampleProto(t *testing.T, sampleProto sampleProto) {
 	}
 
 	for i, file := range response.File {
+		if len(sampleProto.ExpectedFileNames) > 0 && file.GetName() != sampleProto.ExpectedFileNames[i] {
+			t.Errorf("Incorrect JSON-Schema filename for sample proto file (%v): got %s, want %s", sampleProtoFileName, file.GetName(), sampleProto.ExpectedFileNames[i])
+		}
+
 		want := sampleProto.ExpectedJSONSchema[i]
 
 		if diff := cmp.Diff(file.GetContent(), want); diff != "" {
@@ -127,6 +205,31 @@ func configureSampleProtos() {
 		UseProtoAndJSONFieldNames: true,
 	}
 
+	// Deprecated (with a schema for every type, and a branch for every enum value):
+	sampleProtos["Deprecated"] = sampleProto{
+		EnumOneOf:          true,
+		ExpectedFileNames:  []string{"Genre.jsonschema", "Cupboard.jsonschema", "Library.jsonschema", "Library.Status.jsonschema"},
+		ExpectedJSONSchema: []string{testdata.Genre, testdata.Cupboard, testdata.Library, testdata.LibraryStatus},
+		FilesToGenerate:    []string{"Deprecated.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "Deprecated.proto",
+	}
+
+	// Deprecated (leaving out deprecated fields and enum values):
+	sampleProtos["DeprecatedExcluded"] = sampleProto{
+		ExcludeDeprecated:  true,
+		ExpectedJSONSchema: []string{testdata.Cupboard, testdata.LibraryExcludeDeprecated},
+		FilesToGenerate:    []string{"Deprecated.proto"},
+		ProtoFileName:      "Deprecated.proto",
+	}
+
+	// Deprecated (listing deprecated enum values in "x-deprecated", without the branches of enum_oneof):
+	sampleProtos["DeprecatedValues"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.Cupboard, testdata.LibraryDeprecatedValues},
+		FilesToGenerate:    []string{"Deprecated.proto"},
+		ProtoFileName:      "Deprecated.proto",
+	}
+
 	// EnumCeption:
 	sampleProtos["EnumCeption"] = sampleProto{
 		AllowNullValues:    false,
@@ -135,6 +238,168 @@ func configureSampleProtos() {
 		ProtoFileName:      "Enumception.proto",
 	}
 
+	// EnumCeption (with refs):
+	sampleProtos["EnumCeptionRefs"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedJSONSchema: []string{testdata.EnumCeptionRefs},
+		FilesToGenerate:    []string{"Enumception.proto"},
+		ProtoFileName:      "Enumception.proto",
+		UseRefs:            true,
+	}
+
+	// EnumCeption (as an OpenAPI document, with nullable values):
+	sampleProtos["EnumCeptionOpenAPI"] = sampleProto{
+		AllowNullValues:    true,
+		ExpectedFileNames:  []string{"openapi.yaml"},
+		ExpectedJSONSchema: []string{testdata.EnumCeptionOpenAPI},
+		FilesToGenerate:    []string{"Enumception.proto", "PayloadMessage.proto", "ImportedEnum.proto"},
+		Output:             OutputOpenAPI,
+		ProtoFileName:      "Enumception.proto",
+	}
+
+	// EnumEncodings (names only):
+	sampleProtos["EnumEncodingsNames"] = sampleProto{
+		EnumsAs:            EnumsAsNames,
+		ExpectedJSONSchema: []string{testdata.AccountStateNames, testdata.EnumEncodingsNames},
+		FilesToGenerate:    []string{"EnumEncodings.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "EnumEncodings.proto",
+	}
+
+	// EnumEncodings (numbers only):
+	sampleProtos["EnumEncodingsNumbers"] = sampleProto{
+		EnumsAs:            EnumsAsNumbers,
+		ExpectedJSONSchema: []string{testdata.AccountStateNumbers, testdata.EnumEncodingsNumbers},
+		FilesToGenerate:    []string{"EnumEncodings.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "EnumEncodings.proto",
+	}
+
+	// EnumEncodings (with a oneOf branch per value):
+	sampleProtos["EnumEncodingsOneOf"] = sampleProto{
+		EnumOneOf:          true,
+		ExpectedJSONSchema: []string{testdata.AccountStateOneOf, testdata.EnumEncodingsOneOf},
+		FilesToGenerate:    []string{"EnumEncodings.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "EnumEncodings.proto",
+	}
+
+	// EnumEncodings (with a nullable oneOf branch per name, as draft-07):
+	sampleProtos["EnumEncodingsOneOfNamesDraft07"] = sampleProto{
+		AllowNullValues:    true,
+		Draft:              Draft07,
+		EnumOneOf:          true,
+		EnumsAs:            EnumsAsNames,
+		ExpectedJSONSchema: []string{testdata.AccountStateOneOfNamesDraft07, testdata.EnumEncodingsOneOfNamesDraft07},
+		FilesToGenerate:    []string{"EnumEncodings.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "EnumEncodings.proto",
+	}
+
+	// Envelope:
+	sampleProtos["Envelope"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.Envelope},
+		FilesToGenerate:    []string{"Envelope.proto"},
+		ProtoFileName:      "Envelope.proto",
+	}
+
+	// Envelope (with the known types of Any):
+	sampleProtos["EnvelopeResolveAnyTypes"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.EnvelopeResolveAnyTypes},
+		FilesToGenerate:    []string{"Envelope.proto"},
+		ProtoFileName:      "Envelope.proto",
+		ResolveAnyTypes:    true,
+	}
+
+	// FieldBehavior:
+	sampleProtos["FieldBehavior"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.Shelf},
+		FilesToGenerate:    []string{"FieldBehavior.proto"},
+		ProtoFileName:      "FieldBehavior.proto",
+	}
+
+	// FieldBehavior (split into input and output variants):
+	sampleProtos["FieldBehaviorVariants"] = sampleProto{
+		ExpectedFileNames:     []string{"Shelf.jsonschema", "Shelf.input.jsonschema", "Shelf.output.jsonschema"},
+		ExpectedJSONSchema:    []string{testdata.Shelf, testdata.ShelfInput, testdata.ShelfOutput},
+		FieldBehaviorVariants: true,
+		FilesToGenerate:       []string{"FieldBehavior.proto"},
+		ProtoFileName:         "FieldBehavior.proto",
+	}
+
+	// Http (with schemas for the HTTP bodies and query parameters of its methods):
+	sampleProtos["Http"] = sampleProto{
+		ExpectedFileNames: []string{
+			"Book.jsonschema",
+			"GetBookRequest.jsonschema",
+			"CreateBookRequest.jsonschema",
+			"UpdateBookRequest.jsonschema",
+			"MoveBookRequest.jsonschema",
+			"BookService.GetBook.request.jsonschema",
+			"BookService.GetBook.response.jsonschema",
+			"BookService.GetBook.query.jsonschema",
+			"BookService.CreateBook.request.jsonschema",
+			"BookService.CreateBook.response.jsonschema",
+			"BookService.CreateBook.body.jsonschema",
+			"BookService.CreateBook.query.jsonschema",
+			"BookService.UpdateBook.request.jsonschema",
+			"BookService.UpdateBook.response.jsonschema",
+			"BookService.UpdateBook.body.jsonschema",
+			"BookService.UpdateBook.query.jsonschema",
+			"BookService.MoveBook.request.jsonschema",
+			"BookService.MoveBook.response.jsonschema",
+			"BookService.MoveBook.body.jsonschema",
+			"BookService.MoveBook.1.body.jsonschema",
+		},
+		ExpectedJSONSchema: []string{
+			testdata.Book,
+			testdata.GetBookRequest,
+			testdata.CreateBookRequest,
+			testdata.UpdateBookRequest,
+			testdata.MoveBookRequest,
+			testdata.BookServiceGetBookRequest,
+			testdata.BookServiceGetBookResponse,
+			testdata.BookServiceGetBookQuery,
+			testdata.BookServiceCreateBookRequest,
+			testdata.BookServiceCreateBookResponse,
+			testdata.BookServiceCreateBookBody,
+			testdata.BookServiceCreateBookQuery,
+			testdata.BookServiceUpdateBookRequest,
+			testdata.BookServiceUpdateBookResponse,
+			testdata.BookServiceUpdateBookBody,
+			testdata.BookServiceUpdateBookQuery,
+			testdata.BookServiceMoveBookRequest,
+			testdata.BookServiceMoveBookResponse,
+			testdata.BookServiceMoveBookBody,
+			testdata.BookServiceMoveBook1Body,
+		},
+		FilesToGenerate: []string{"Http.proto"},
+		GenerateMethods: true,
+		ProtoFileName:   "Http.proto",
+	}
+
+	// Http (with a path into an optional message, whose reference is wrapped in a "oneOf" with NULL):
+	sampleProtos["HttpOptionalRefs"] = sampleProto{
+		ExpectedFileNames: []string{
+			"RenameBookRequest.jsonschema",
+			"ShelfService.RenameBook.request.jsonschema",
+			"ShelfService.RenameBook.response.jsonschema",
+			"ShelfService.RenameBook.body.jsonschema",
+			"ShelfService.RenameBook.query.jsonschema",
+		},
+		ExpectedJSONSchema: []string{
+			testdata.RenameBookRequest,
+			testdata.ShelfServiceRenameBookRequest,
+			testdata.ShelfServiceRenameBookResponse,
+			testdata.ShelfServiceRenameBookBody,
+			testdata.ShelfServiceRenameBookQuery,
+		},
+		FilesToGenerate: []string{"HttpOptional.proto"},
+		GenerateMethods: true,
+		ProtoFileName:   "HttpOptional.proto",
+		UseRefs:         true,
+	}
+
 	// ImportedEnum:
 	sampleProtos["ImportedEnum"] = sampleProto{
 		AllowNullValues:    false,
@@ -143,6 +408,14 @@ func configureSampleProtos() {
 		ProtoFileName:      "ImportedEnum.proto",
 	}
 
+	// ImportedEnum (as draft 2019-09):
+	sampleProtos["ImportedEnumDraft201909"] = sampleProto{
+		Draft:              Draft201909,
+		ExpectedJSONSchema: []string{testdata.ImportedEnumDraft201909},
+		FilesToGenerate:    []string{"ImportedEnum.proto"},
+		ProtoFileName:      "ImportedEnum.proto",
+	}
+
 	// NestedMessage:
 	sampleProtos["NestedMessage"] = sampleProto{
 		AllowNullValues:    false,
@@ -151,6 +424,15 @@ func configureSampleProtos() {
 		ProtoFileName:      "NestedMessage.proto",
 	}
 
+	// NestedMessage (as an OpenAPI document in JSON):
+	sampleProtos["NestedMessageOpenAPIJSON"] = sampleProto{
+		ExpectedFileNames:  []string{"openapi.json"},
+		ExpectedJSONSchema: []string{testdata.NestedMessageOpenAPIJSON},
+		FilesToGenerate:    []string{"NestedMessage.proto"},
+		Output:             OutputOpenAPIJSON,
+		ProtoFileName:      "NestedMessage.proto",
+	}
+
 	// NestedObject:
 	sampleProtos["NestedObject"] = sampleProto{
 		AllowNullValues:    false,
@@ -159,6 +441,53 @@ func configureSampleProtos() {
 		ProtoFileName:      "NestedObject.proto",
 	}
 
+	// NestedObject (with schemas for the nested types):
+	sampleProtos["NestedObjectAllTypes"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedFileNames:  []string{"NestedObject.jsonschema", "NestedObject.NestedPayload.jsonschema", "NestedObject.NestedPayload.Topology.jsonschema"},
+		ExpectedJSONSchema: []string{testdata.NestedObject, testdata.NestedObjectNestedPayload, testdata.NestedObjectNestedPayloadTopology},
+		FilesToGenerate:    []string{"NestedObject.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "NestedObject.proto",
+	}
+
+	// OneOf:
+	sampleProtos["OneOf"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedJSONSchema: []string{testdata.OneOf},
+		FilesToGenerate:    []string{"OneOf.proto"},
+		ProtoFileName:      "OneOf.proto",
+	}
+
+	// OneOf (with proto and JSON field names):
+	sampleProtos["OneOfDouble"] = sampleProto{
+		AllowNullValues:           false,
+		ExpectedJSONSchema:        []string{testdata.OneOfDouble},
+		FilesToGenerate:           []string{"OneOf.proto"},
+		ProtoFileName:             "OneOf.proto",
+		UseProtoAndJSONFieldNames: true,
+	}
+
+	// OtherPackage (named after the full name of each message):
+	sampleProtos["OtherPackageFullName"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedFileNames:  []string{"samples.PayloadMessage.jsonschema", "samples.other.PayloadMessage.jsonschema"},
+		ExpectedJSONSchema: []string{testdata.PayloadMessage, testdata.OtherPackagePayloadMessage},
+		FileNaming:         FileNamingFullName,
+		FilesToGenerate:    []string{"OtherPackage.proto", "PayloadMessage.proto"},
+		ProtoFileName:      "OtherPackage.proto",
+	}
+
+	// OtherPackage (with a directory for each package):
+	sampleProtos["OtherPackagePackageDirs"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedFileNames:  []string{"samples/PayloadMessage.jsonschema", "samples/other/PayloadMessage.jsonschema"},
+		ExpectedJSONSchema: []string{testdata.PayloadMessage, testdata.OtherPackagePayloadMessage},
+		FileNaming:         FileNamingPackageDirs,
+		FilesToGenerate:    []string{"OtherPackage.proto", "PayloadMessage.proto"},
+		ProtoFileName:      "OtherPackage.proto",
+	}
+
 	// PayloadMessage:
 	sampleProtos["PayloadMessage"] = sampleProto{
 		AllowNullValues:    false,
@@ -167,6 +496,122 @@ func configureSampleProtos() {
 		ProtoFileName:      "PayloadMessage.proto",
 	}
 
+	// Presence:
+	sampleProtos["Presence"] = sampleProto{
+		AllowNullValues:               false,
+		ExpectedJSONSchema:            []string{testdata.Presence},
+		FilesToGenerate:               []string{"Presence.proto"},
+		ProtoFileName:                 "Presence.proto",
+		RequireImplicitPresenceFields: true,
+	}
+
+	// Presence (with NULL values allowed everywhere, optional enums included):
+	sampleProtos["PresenceNullValues"] = sampleProto{
+		AllowNullValues:    true,
+		ExpectedJSONSchema: []string{testdata.PresenceNullValues},
+		FilesToGenerate:    []string{"Presence.proto"},
+		ProtoFileName:      "Presence.proto",
+	}
+
+	// Recursion:
+	sampleProtos["Recursion"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedJSONSchema: []string{testdata.TreeNode, testdata.Comment, testdata.Thread, testdata.Graph},
+		FilesToGenerate:    []string{"Recursion.proto"},
+		ProtoFileName:      "Recursion.proto",
+	}
+
+	// RequiredFields:
+	sampleProtos["RequiredFields"] = sampleProto{
+		AllowNullValues:    false,
+		ExpectedJSONSchema: []string{testdata.RequiredFields},
+		FilesToGenerate:    []string{"RequiredFields.proto"},
+		ProtoFileName:      "RequiredFields.proto",
+	}
+
+	// RequiredFields (with proto and JSON field names):
+	sampleProtos["RequiredFieldsDouble"] = sampleProto{
+		AllowNullValues:           false,
+		ExpectedJSONSchema:        []string{testdata.RequiredFieldsDouble},
+		FilesToGenerate:           []string{"RequiredFields.proto"},
+		ProtoFileName:             "RequiredFields.proto",
+		UseProtoAndJSONFieldNames: true,
+	}
+
+	// Scalars:
+	sampleProtos["Scalars"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.Scalars},
+		FilesToGenerate:    []string{"Scalars.proto"},
+		ProtoFileName:      "Scalars.proto",
+	}
+
+	// Scalars (with the range of float32 enforced):
+	sampleProtos["ScalarsFloat32Range"] = sampleProto{
+		EnforceFloat32Range: true,
+		ExpectedJSONSchema:  []string{testdata.ScalarsFloat32Range},
+		FilesToGenerate:     []string{"Scalars.proto"},
+		ProtoFileName:       "Scalars.proto",
+	}
+
+	// Scalars (accepting everything protojson does):
+	sampleProtos["ScalarsProtojsonCompat"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.ScalarsProtojsonCompat},
+		FilesToGenerate:    []string{"Scalars.proto"},
+		ProtoFileName:      "Scalars.proto",
+		ProtojsonCompat:    true,
+	}
+
+	// SchemaOptions:
+	sampleProtos["SchemaOptions"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.SchemaOptions},
+		FilesToGenerate:    []string{"SchemaOptions.proto"},
+		ProtoFileName:      "SchemaOptions.proto",
+	}
+
+	// SchemaOptions (ignored types don't get schemas of their own):
+	sampleProtos["SchemaOptionsGenerateAllTypes"] = sampleProto{
+		ExpectedFileNames:  []string{"Colour.jsonschema", "SchemaOptions.jsonschema", "SchemaOptions.Settings.jsonschema"},
+		ExpectedJSONSchema: []string{testdata.Colour, testdata.SchemaOptions, testdata.SchemaOptionsSettings},
+		FilesToGenerate:    []string{"SchemaOptions.proto"},
+		GenerateAllTypes:   true,
+		ProtoFileName:      "SchemaOptions.proto",
+	}
+
+	// SchemaOptions (with the options of referenced messages in their definitions):
+	sampleProtos["SchemaOptionsRefs"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.SchemaOptionsRefs},
+		FilesToGenerate:    []string{"SchemaOptions.proto"},
+		ProtoFileName:      "SchemaOptions.proto",
+		UseRefs:            true,
+	}
+
+	// Service (with schemas for the requests and responses of its methods):
+	sampleProtos["Service"] = sampleProto{
+		ExpectedFileNames: []string{
+			"UploadResult.jsonschema",
+			"WatchRequest.jsonschema",
+			"PayloadService.Store.request.jsonschema",
+			"PayloadService.Store.response.jsonschema",
+			"PayloadService.Upload.request.jsonschema",
+			"PayloadService.Upload.response.jsonschema",
+			"PayloadService.Watch.request.jsonschema",
+			"PayloadService.Watch.response.jsonschema",
+		},
+		ExpectedJSONSchema: []string{
+			testdata.UploadResult,
+			testdata.WatchRequest,
+			testdata.PayloadServiceStoreRequest,
+			testdata.PayloadServiceStoreResponse,
+			testdata.PayloadServiceUploadRequest,
+			testdata.PayloadServiceUploadResponse,
+			testdata.PayloadServiceWatchRequest,
+			testdata.PayloadServiceWatchResponse,
+		},
+		FilesToGenerate: []string{"Service.proto"},
+		GenerateMethods: true,
+		ProtoFileName:   "Service.proto",
+	}
+
 	// SeveralEnums:
 	sampleProtos["SeveralEnums"] = sampleProto{
 		AllowNullValues:    false,
@@ -199,6 +644,25 @@ func configureSampleProtos() {
 		ProtoFileName:      "Maps.proto",
 	}
 
+	// Maps (with refs):
+	sampleProtos["MapsRefs"] = sampleProto{
+		AllowNullValues:    true,
+		ExpectedJSONSchema: []string{testdata.MapsRefs},
+		FilesToGenerate:    []string{"Maps.proto"},
+		ProtoFileName:      "Maps.proto",
+		UseRefs:            true,
+	}
+
+	// Maps (with refs, as draft 2020-12):
+	sampleProtos["MapsRefsDraft202012"] = sampleProto{
+		AllowNullValues:    true,
+		Draft:              Draft202012,
+		ExpectedJSONSchema: []string{testdata.MapsRefsDraft202012},
+		FilesToGenerate:    []string{"Maps.proto"},
+		ProtoFileName:      "Maps.proto",
+		UseRefs:            true,
+	}
+
 	// Comments:
 	sampleProtos["Comments"] = sampleProto{
 		AllowNullValues:    false,
@@ -212,6 +676,230 @@ func configureSampleProtos() {
 		FilesToGenerate:    []string{"WellKnown.proto"},
 		ProtoFileName:      "WellKnown.proto",
 	}
+
+	sampleProtos["WellKnownProtojsonCompat"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.WellKnownProtojsonCompat},
+		FilesToGenerate:    []string{"WellKnown.proto"},
+		ProtoFileName:      "WellKnown.proto",
+		ProtojsonCompat:    true,
+	}
+
+	sampleProtos["WellKnownTypeSystem"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.WellKnownTypeSystem},
+		FilesToGenerate:    []string{"WellKnownTypeSystem.proto"},
+		ProtoFileName:      "WellKnownTypeSystem.proto",
+		UseRefs:            true,
+	}
+
+	// Validate:
+	sampleProtos["Validate"] = sampleProto{
+		ExpectedJSONSchema: []string{testdata.Validate},
+		FilesToGenerate:    []string{"Validate.proto"},
+		ProtoFileName:      "Validate.proto",
+	}
+
+	// Validate (with numeric "exclusiveMinimum" and "exclusiveMaximum"):
+	sampleProtos["ValidateDraft202012"] = sampleProto{
+		Draft:              Draft202012,
+		ExpectedJSONSchema: []string{testdata.ValidateDraft202012},
+		FilesToGenerate:    []string{"Validate.proto"},
+		ProtoFileName:      "Validate.proto",
+	}
+}
+
+func TestFileNameCollision(t *testing.T) {
+
+	// Make a Logrus logger:
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	logger.SetOutput(os.Stderr)
+
+	// Two messages called "PayloadMessage" (from different packages) can't both be named after their message name:
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "OtherPackage.proto")
+	codeGeneratorRequest := plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"OtherPackage.proto", "PayloadMessage.proto"},
+		ProtoFile:      fileDescriptorSet.GetFile(),
+	}
+
+	response, err := New(logger).convert(&codeGeneratorRequest)
+	if err == nil {
+		t.Fatal("Expected an error for colliding JSON-Schema filenames")
+	}
+	if !strings.Contains(response.GetError(), "PayloadMessage.jsonschema") {
+		t.Errorf("Expected the error to mention the colliding filename, got: %s", response.GetError())
+	}
+}
+
+func TestHTTPBodyExcluded(t *testing.T) {
+
+	// Make a Logrus logger:
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+	logger.SetOutput(os.Stderr)
+
+	// The body of a method can't be a field which has been left out of its request:
+	protoConverter := New(logger)
+	if err := protoConverter.parseGeneratorParameters("generate_methods,exclude_deprecated"); err != nil {
+		t.Fatal(err)
+	}
+	fileDescriptorSet := mustReadProtoFiles(t, sampleProtoDirectory, "HttpExcludedBody.proto")
+	codeGeneratorRequest := plugin.CodeGeneratorRequest{
+		FileToGenerate: []string{"HttpExcludedBody.proto"},
+		ProtoFile:      fileDescriptorSet.GetFile(),
+	}
+
+	response, err := protoConverter.convert(&codeGeneratorRequest)
+	if err == nil {
+		t.Fatal("Expected an error for a body which has been excluded from its request")
+	}
+	if !strings.Contains(response.GetError(), "payload") || !strings.Contains(response.GetError(), "excluded") {
+		t.Errorf("Expected the error to mention the excluded body, got: %s", response.GetError())
+	}
+}
+
+func TestProtojsonCompatScalars(t *testing.T) {
+	schemaLoader := gojsonschema.NewStringLoader(testdata.ScalarsProtojsonCompat)
+
+	// Numbers as strings are accepted wherever protojson reads them:
+	for _, scalar := range []struct {
+		field string
+		value string
+		valid bool
+	}{
+		{"int32_value", "1.5e1", true},
+		{"int32_value", "1.50e1", true},
+		{"int32_value", "100e-2", true},
+		{"int32_value", "-2147483648", true},
+		{"int32_value", "1.5", false},
+		{"int32_value", "9999999999", false},
+		{"int32_value", "2147483648", false},
+		{"uint32_value", "4294967295", true},
+		{"uint32_value", "-1", false},
+		{"int64_value", "-9223372036854775808", true},
+		{"int64_value", "1e-1", false},
+		{"int64_value", "84272596281124936957", false},
+		{"int64_value", "-9223372036854775809", false},
+		{"uint64_value", "18446744073709551615", true},
+		{"uint64_value", "9e19", false},
+		{"uint32_value", "0.013e+11", true},
+		{"uint32_value", "0.043e+11", false},
+		{"double_value", "1.7976931348623157e308", true},
+		{"double_value", "NaN", true},
+		{"double_value", "1e400", false},
+		{"double_value", "2493578642367648E+23", true},
+		{"double_value", "357372548666371711453e1", true},
+		{"double_value", "17976931348623159e292", false},
+		{"float_value", "3.4028235e38", true},
+		{"float_value", "-Infinity", true},
+		{"float_value", "1e39", false},
+		{"float_value", "2493578642367648E+23", true},
+		{"float_value", "357372548666371711453e1", true},
+		{"float_value", "3402823567797336617e20", false},
+	} {
+		documentLoader := gojsonschema.NewStringLoader(fmt.Sprintf(`{%q: %q}`, scalar.field, scalar.value))
+		result, err := gojsonschema.Validate(schemaLoader, documentLoader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid() != scalar.valid {
+			t.Errorf("Expected %s %q to be valid=%v, got %v", scalar.field, scalar.value, scalar.valid, result.Errors())
+		}
+	}
+}
+
+func TestNestedMessageConstraints(t *testing.T) {
+
+	// Singular nested messages keep their oneofs and required fields (like repeated ones do):
+	for _, document := range []struct {
+		schema   string
+		document string
+		valid    bool
+	}{
+		{testdata.OneOf, `{"contact": {"email": "ada@example.com"}}`, true},
+		{testdata.OneOf, `{"contact": {"email": "ada@example.com", "phone": "555"}}`, false},
+		{testdata.RequiredFields, `{"name": "ada", "user_id": 1, "payload": {}, "inner": {"id": "1"}}`, true},
+		{testdata.RequiredFields, `{"name": "ada", "user_id": 1, "payload": {}, "inner": {"label": "one"}}`, false},
+		{testdata.RequiredFields, `{"name": "ada", "user_id": 1, "payload": {}, "inners": [{"label": "one"}]}`, false},
+	} {
+		result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(document.schema), gojsonschema.NewStringLoader(document.document))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid() != document.valid {
+			t.Errorf("Expected %s to be valid=%v, got %v", document.document, document.valid, result.Errors())
+		}
+	}
+}
+
+func TestNullEnumValues(t *testing.T) {
+
+	// Enums accept NULL when NULL values are allowed (as the fields of nested messages, and as optional fields):
+	for _, document := range []struct {
+		schema   string
+		document string
+		valid    bool
+	}{
+		{testdata.ArrayOfObjects, `{"payload": [{"topology": null}]}`, true},
+		{testdata.ArrayOfObjects, `{"payload": [{"topology": "FLAT"}]}`, true},
+		{testdata.ArrayOfObjects, `{"payload": [{"topology": "ROUND"}]}`, false},
+		{testdata.PresenceNullValues, `{"topology": null}`, true},
+		{testdata.PresenceNullValues, `{"topology": "ROUND"}`, false},
+		{testdata.EnumCeption, `{"failureMode": null}`, false},
+	} {
+		result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(document.schema), gojsonschema.NewStringLoader(document.document))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid() != document.valid {
+			t.Errorf("Expected %s to be valid=%v, got %v", document.document, document.valid, result.Errors())
+		}
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+
+	// Keys are checked in draft-04 (by "patternProperties") as well as in later drafts (by "propertyNames"):
+	for _, document := range []struct {
+		schema   string
+		document string
+		valid    bool
+	}{
+		{testdata.Maps, `{"map_of_flags": {"true": "yes"}, "map_by_id": {"18446744073709551615": "max"}}`, true},
+		{testdata.Maps, `{"map_of_flags": {"maybe": "yes"}}`, false},
+		{testdata.Maps, `{"map_by_id": {"18446744073709551616": "too big"}}`, false},
+		{testdata.Maps, `{"map_by_id": {"1": 1}}`, false},
+		{testdata.MapsRefsDraft202012, `{"map_of_flags": {"maybe": "yes"}}`, false},
+	} {
+		result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(document.schema), gojsonschema.NewStringLoader(document.document))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Valid() != document.valid {
+			t.Errorf("Expected %s to be valid=%v, got %v", document.document, document.valid, result.Errors())
+		}
+	}
+}
+
+func TestOpenAPIParameters(t *testing.T) {
+	protoConverter := New(logrus.New())
+	if err := protoConverter.parseGeneratorParameters("output=openapi,disallow_additional_properties"); err != nil {
+		t.Fatal(err)
+	}
+	if protoConverter.Draft != Draft202012 || !protoConverter.UseRefs {
+		t.Errorf("Expected OpenAPI output to use draft %s with refs, got draft %q (use_refs=%v)", Draft202012, protoConverter.Draft, protoConverter.UseRefs)
+	}
+
+	// OpenAPI 3.1 can't be combined with an older draft:
+	if err := New(logrus.New()).parseGeneratorParameters("output=openapi,draft=07"); err == nil {
+		t.Error("Expected an error for OpenAPI output with draft 07")
+	}
+
+	// Nor with the parameters which only apply to JSON-Schema files:
+	for _, parameter := range []string{"file_naming=full_name", "generate_methods", "field_behavior_variants", "generate_all_types"} {
+		if err := New(logrus.New()).parseGeneratorParameters("output=openapi," + parameter); err == nil {
+			t.Errorf("Expected an error for OpenAPI output with %s", parameter)
+		}
+	}
 }
 
 // Load the specified .proto files into a FileDescriptorSet. Any errors in loading/parsing will
@@ -228,6 +916,7 @@ func mustReadProtoFiles(t *testing.T, includePath string, filenames ...string) *
 	args = append(args, "--include_source_info")
 	args = append(args, "--include_imports")
 	args = append(args, "--proto_path="+includePath)
+	args = append(args, "--proto_path="+optionsProtoDirectory)
 	args = append(args, filenames...)
 	cmd := exec.Command(protocBinary, args...)
 	stdoutBuf := bytes.Buffer{}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Returns a "$ref" to the shared definition of a message, converting the message first if it hasn't been defined yet:
+func (c *Converter) messageDefinitionRef(curPkg *ProtoPackage, msg *descriptor.DescriptorProto, pkgName, typeName string) (*jsonschema.Type, error) {
+
+	// Definitions are keyed by the fully-qualified proto name of the message:
+	definitionName := strings.TrimPrefix(typeName, ".")
+	ref := &jsonschema.Type{Ref: c.definitionsRefPrefix() + definitionName}
+	if _, ok := c.definitions[definitionName]; ok {
+		return ref, nil
+	}
+
+	// Register a placeholder before converting, so that references back to this message can find it:
+	definition := &jsonschema.Type{}
+	c.definitions[definitionName] = definition
+
+	c.logger.WithField("definition_name", definitionName).Debug("Adding definition")
+	convertedJSONSchemaType, err := c.convertMessageType(curPkg, msg, pkgName)
+	if err != nil {
+		return nil, err
+	}
+	*definition = *convertedJSONSchemaType
+	definition.Version = ""
+
+	return ref, nil
+}
+
+// Returns a "$ref" to the shared definition of an ENUM, converting the enum first if it hasn't been defined yet:
+func (c *Converter) enumDefinitionRef(enum *descriptor.EnumDescriptorProto, typeName string) (*jsonschema.Type, error) {
+
+	// Definitions are keyed by the fully-qualified proto name of the enum:
+	definitionName := strings.TrimPrefix(typeName, ".")
+	ref := &jsonschema.Type{Ref: c.definitionsRefPrefix() + definitionName}
+	if _, ok := c.definitions[definitionName]; ok {
+		return ref, nil
+	}
+
+	c.logger.WithField("definition_name", definitionName).Debug("Adding definition")
+	definition, err := c.convertEnumType(enum)
+	if err != nil {
+		return nil, err
+	}
+	definition.Version = ""
+	c.definitions[definitionName] = &definition
+
+	return ref, nil
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Marks the schema of a deprecated field, message, enum or enum value as "deprecated":
+func setDeprecated(jsonSchemaType *jsonschema.Type, deprecated bool) {
+	if deprecated {
+		setExtra(jsonSchemaType, "deprecated", true)
+	}
+}
+
+// Tells whether a field is left out for being deprecated:
+func (c *Converter) excludedAsDeprecated(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	return c.ExcludeDeprecated && fieldDesc.GetOptions().GetDeprecated()
+}
+
+// Returns the values of an ENUM (without the deprecated ones, when we're leaving them out):
+func (c *Converter) enumValues(enum *descriptor.EnumDescriptorProto) []*descriptor.EnumValueDescriptorProto {
+	if !c.ExcludeDeprecated {
+		return enum.GetValue()
+	}
+	var enumValues []*descriptor.EnumValueDescriptorProto
+	for _, enumValue := range enum.GetValue() {
+		if !enumValue.GetOptions().GetDeprecated() {
+			enumValues = append(enumValues, enumValue)
+		}
+	}
+	return enumValues
+}
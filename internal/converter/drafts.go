@@ -0,0 +1,226 @@
+package converter
+
+import (
+	"reflect"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// JSON-Schema drafts which can be generated (draft-04 being the default):
+const (
+	Draft04     = "04"
+	Draft06     = "06"
+	Draft07     = "07"
+	Draft201909 = "2019-09"
+	Draft202012 = "2020-12"
+)
+
+var (
+	// The drafts in chronological order:
+	drafts = []string{Draft04, Draft06, Draft07, Draft201909, Draft202012}
+
+	// The "$schema" URI of each draft:
+	draftSchemaURIs = map[string]string{
+		Draft04:     jsonschema.Version,
+		Draft06:     "http://json-schema.org/draft-06/schema#",
+		Draft07:     "http://json-schema.org/draft-07/schema#",
+		Draft201909: "https://json-schema.org/draft/2019-09/schema",
+		Draft202012: "https://json-schema.org/draft/2020-12/schema",
+	}
+)
+
+// Tells whether the configured draft is the given one, or a later one:
+func (c *Converter) draftAtLeast(draft string) bool {
+	current := c.Draft
+	if current == "" {
+		current = Draft04
+	}
+	for _, d := range drafts {
+		if d == draft {
+			return true
+		}
+		if d == current {
+			return false
+		}
+	}
+	return false
+}
+
+// The "$schema" URI to stamp on the generated schemas:
+func (c *Converter) schemaVersion() string {
+	if uri, ok := draftSchemaURIs[c.Draft]; ok {
+		return uri
+	}
+	return jsonschema.Version
+}
+
+// Where shared definitions live ("definitions" became "$defs" in 2019-09, and OpenAPI keeps them in its components):
+func (c *Converter) definitionsRefPrefix() string {
+	if c.generatesOpenAPI() {
+		return openAPIRefPrefix
+	}
+	if c.draftAtLeast(Draft201909) {
+		return "#/$defs/"
+	}
+	return "#/definitions/"
+}
+
+// Attaches shared definitions to a root schema:
+func (c *Converter) setDefinitions(jsonSchemaType *jsonschema.Type, definitions jsonschema.Definitions) {
+	if c.draftAtLeast(Draft201909) {
+		setExtra(jsonSchemaType, "$defs", definitions)
+		return
+	}
+	jsonSchemaType.Definitions = definitions
+}
+
+// Sets the JSON type(s) of a schema. Several types are expressed as a "oneOf" for draft-04 (which is what we've
+// always generated), and as a "type" array for later drafts:
+func (c *Converter) setTypes(jsonSchemaType *jsonschema.Type, jsonTypes ...string) {
+	switch {
+	case len(jsonTypes) == 1:
+		jsonSchemaType.Type = jsonTypes[0]
+	case c.draftAtLeast(Draft06):
+		jsonSchemaType.Type = ""
+		setExtra(jsonSchemaType, "type", jsonTypes)
+	default:
+		jsonSchemaType.Type = ""
+		for _, jsonType := range jsonTypes {
+			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: jsonType})
+		}
+	}
+}
+
+// Restricts a schema to the given values ("const" can only express a single value, and only exists since draft-06):
+func (c *Converter) setConst(jsonSchemaType *jsonschema.Type, values ...interface{}) {
+	if len(values) == 1 && c.draftAtLeast(Draft06) {
+		setExtra(jsonSchemaType, "const", values[0])
+		return
+	}
+	jsonSchemaType.Enum = values
+}
+
+// Sets a lower bound ("exclusiveMinimum" was a boolean modifier of "minimum" in draft-04, and is a number of its own
+// since draft-06):
+func (c *Converter) setMinimum(jsonSchemaType *jsonschema.Type, minimum interface{}, exclusive bool) {
+	switch {
+	case !exclusive:
+		setExtra(jsonSchemaType, "minimum", minimum)
+	case c.draftAtLeast(Draft06):
+		setExtra(jsonSchemaType, "exclusiveMinimum", minimum)
+	default:
+		setExtra(jsonSchemaType, "minimum", minimum)
+		setExtra(jsonSchemaType, "exclusiveMinimum", true)
+	}
+}
+
+// Sets an upper bound (the same way as setMinimum):
+func (c *Converter) setMaximum(jsonSchemaType *jsonschema.Type, maximum interface{}, exclusive bool) {
+	switch {
+	case !exclusive:
+		setExtra(jsonSchemaType, "maximum", maximum)
+	case c.draftAtLeast(Draft06):
+		setExtra(jsonSchemaType, "exclusiveMaximum", maximum)
+	default:
+		setExtra(jsonSchemaType, "maximum", maximum)
+		setExtra(jsonSchemaType, "exclusiveMaximum", true)
+	}
+}
+
+// Returns the given JSON types, preceded by NULL if we're allowing NULL values:
+func (c *Converter) withNull(jsonTypes ...string) []string {
+	if c.AllowNullValues {
+		return append([]string{gojsonschema.TYPE_NULL}, jsonTypes...)
+	}
+	return jsonTypes
+}
+
+// Makes a schema accept NULL as well. Schemas which are only restricted by their type(s) get NULL added to them through
+// setTypes (and to their "enum", if they have one). Anything else (like a "$ref", or a schema with combinators which
+// would reject NULL) is wrapped in a "oneOf" with NULL instead:
+func (c *Converter) nullable(jsonSchemaType *jsonschema.Type) *jsonschema.Type {
+	jsonTypes := schemaTypes(jsonSchemaType)
+	for _, jsonType := range jsonTypes {
+		if jsonType == gojsonschema.TYPE_NULL {
+			return jsonSchemaType
+		}
+	}
+
+	_, hasConst := jsonSchemaType.Extras["const"]
+	if len(jsonTypes) == 0 || hasConst || jsonSchemaType.AllOf != nil || jsonSchemaType.AnyOf != nil || jsonSchemaType.Not != nil {
+		if isNullableWrapper(jsonSchemaType) {
+			return jsonSchemaType
+		}
+		wrappedType := *jsonSchemaType
+		wrappedType.Description = ""
+		return &jsonschema.Type{
+			OneOf:       []*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}, &wrappedType},
+			Description: jsonSchemaType.Description,
+		}
+	}
+
+	// Copy the schema (it may be shared, like the schemas of well-known types):
+	nullableType := *jsonSchemaType
+	nullableType.Extras = make(map[string]interface{})
+	for keyword, value := range jsonSchemaType.Extras {
+		nullableType.Extras[keyword] = value
+	}
+	nullableType.OneOf = nil
+	if len(nullableType.Enum) > 0 {
+		nullableType.Enum = append(append([]interface{}(nil), nullableType.Enum...), nil)
+	}
+	c.setTypes(&nullableType, append([]string{gojsonschema.TYPE_NULL}, jsonTypes...)...)
+	return &nullableType
+}
+
+// Tells whether a schema is a "oneOf" of NULL and another schema (which nullable wraps schemas in):
+func isNullableWrapper(jsonSchemaType *jsonschema.Type) bool {
+	return len(jsonSchemaType.OneOf) == 2 && reflect.DeepEqual(*jsonSchemaType.OneOf[0], jsonschema.Type{Type: gojsonschema.TYPE_NULL})
+}
+
+// Returns the JSON types of a schema, from its "type" (or "type" array), or from a draft-04 "oneOf" of bare types. There
+// are none when the schema has a "$ref", or a "oneOf" which does more than list types:
+func schemaTypes(jsonSchemaType *jsonschema.Type) []string {
+	if jsonSchemaType.Ref != "" {
+		return nil
+	}
+	if jsonTypes, ok := jsonSchemaType.Extras["type"].([]string); ok {
+		return jsonTypes
+	}
+	if jsonSchemaType.Type != "" {
+		if len(jsonSchemaType.OneOf) > 0 {
+			return nil
+		}
+		return []string{jsonSchemaType.Type}
+	}
+	var jsonTypes []string
+	for _, branch := range jsonSchemaType.OneOf {
+		if !reflect.DeepEqual(*branch, jsonschema.Type{Type: branch.Type}) {
+			return nil
+		}
+		jsonTypes = append(jsonTypes, branch.Type)
+	}
+	return jsonTypes
+}
+
+// Allows or disallows properties which aren't described by an object's schema ("unevaluatedProperties" is the
+// preferred way of closing objects since 2019-09):
+func (c *Converter) setAdditionalProperties(jsonSchemaType *jsonschema.Type, allowed bool) {
+	switch {
+	case allowed:
+		jsonSchemaType.AdditionalProperties = []byte("true")
+	case c.draftAtLeast(Draft201909):
+		setExtra(jsonSchemaType, "unevaluatedProperties", false)
+	default:
+		jsonSchemaType.AdditionalProperties = []byte("false")
+	}
+}
+
+// Sets a keyword which jsonschema.Type doesn't have a field for:
+func setExtra(jsonSchemaType *jsonschema.Type, keyword string, value interface{}) {
+	if jsonSchemaType.Extras == nil {
+		jsonSchemaType.Extras = make(map[string]interface{})
+	}
+	jsonSchemaType.Extras[keyword] = value
+}
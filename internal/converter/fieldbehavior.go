@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The field option which AIP-style protos keep their google.api.FieldBehavior annotations in:
+const fieldBehaviorExtension = "google.api.field_behavior"
+
+// The google.api.FieldBehavior values which change a schema:
+const (
+	fieldBehaviorRequired   = 2
+	fieldBehaviorOutputOnly = 3
+	fieldBehaviorInputOnly  = 4
+)
+
+// Variants of a message (when splitting them by field behavior):
+const (
+	fieldBehaviorVariantInput  = "input"  // What clients send (without OUTPUT_ONLY fields)
+	fieldBehaviorVariantOutput = "output" // What servers return (without INPUT_ONLY fields)
+)
+
+// Tells whether a field is annotated with the given google.api.FieldBehavior:
+func (c *Converter) hasFieldBehavior(fieldDesc *descriptor.FieldDescriptorProto, behavior int32) bool {
+	behaviors, ok := c.options.extension(fieldDesc.GetOptions(), fieldBehaviorExtension)
+	if !ok {
+		return false
+	}
+	for i := 0; i < behaviors.List().Len(); i++ {
+		if int32(behaviors.List().Get(i).Enum()) == behavior {
+			return true
+		}
+	}
+	return false
+}
+
+// Marks OUTPUT_ONLY fields as "readOnly" and INPUT_ONLY fields as "writeOnly":
+func (c *Converter) applyFieldBehavior(fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) {
+	if c.hasFieldBehavior(fieldDesc, fieldBehaviorOutputOnly) {
+		setExtra(jsonSchemaType, "readOnly", true)
+	}
+	if c.hasFieldBehavior(fieldDesc, fieldBehaviorInputOnly) {
+		setExtra(jsonSchemaType, "writeOnly", true)
+	}
+}
+
+// Tells whether a field is left out of the variant of its message which we're converting:
+func (c *Converter) excludedByFieldBehavior(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	switch c.fieldBehaviorVariant {
+	case fieldBehaviorVariantInput:
+		return c.hasFieldBehavior(fieldDesc, fieldBehaviorOutputOnly)
+	case fieldBehaviorVariantOutput:
+		return c.hasFieldBehavior(fieldDesc, fieldBehaviorInputOnly)
+	}
+	return false
+}
+
+// Picks the variant of the messages of a method ("input" for requests, "output" for responses) when splitting them:
+func (c *Converter) methodFieldBehaviorVariant(direction string) string {
+	if !c.FieldBehaviorVariants {
+		return ""
+	}
+	if direction == "response" {
+		return fieldBehaviorVariantOutput
+	}
+	return fieldBehaviorVariantInput
+}
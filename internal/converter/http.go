@@ -0,0 +1,312 @@
+package converter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"github.com/iancoleman/orderedmap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The method option which describes how a method is transcoded from HTTP:
+const httpRuleExtension = "google.api.http"
+
+// The variables of a path template ("{name}", or "{name=projects/*}" for a variable matching a pattern):
+var pathVariablePattern = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// An HTTP method and path which a method is bound to, along with the field which carries the HTTP body:
+type httpBinding struct {
+	method string
+	path   string
+	body   string
+}
+
+// Returns the HTTP bindings of a method (its google.api.http rule, followed by any additional bindings):
+func (c *Converter) httpBindings(method *descriptor.MethodDescriptorProto) []httpBinding {
+	rule, ok := c.options.extension(method.GetOptions(), httpRuleExtension)
+	if !ok {
+		return nil
+	}
+
+	var bindings []httpBinding
+	if binding, ok := newHTTPBinding(rule.Message()); ok {
+		bindings = append(bindings, binding)
+	}
+	if additionalBindings, ok := ruleValue(rule.Message(), "additional_bindings"); ok {
+		for i := 0; i < additionalBindings.List().Len(); i++ {
+			if binding, ok := newHTTPBinding(additionalBindings.List().Get(i).Message()); ok {
+				bindings = append(bindings, binding)
+			}
+		}
+	}
+	return bindings
+}
+
+// Reads the pattern and body of a single google.api.HttpRule:
+func newHTTPBinding(rule protoreflect.Message) (httpBinding, bool) {
+	patternField := rule.WhichOneof(rule.Descriptor().Oneofs().ByName("pattern"))
+	if patternField == nil {
+		return httpBinding{}, false
+	}
+
+	binding := httpBinding{}
+	if patternField.Name() == "custom" {
+		custom := rule.Get(patternField).Message()
+		if kind, ok := ruleValue(custom, "kind"); ok {
+			binding.method = kind.String()
+		}
+		if customPath, ok := ruleValue(custom, "path"); ok {
+			binding.path = customPath.String()
+		}
+	} else {
+		binding.method = strings.ToUpper(string(patternField.Name()))
+		binding.path = rule.Get(patternField).String()
+	}
+	if body, ok := ruleValue(rule, "body"); ok {
+		binding.body = body.String()
+	}
+	return binding, true
+}
+
+// The (dot-separated) field paths which the path template of a binding captures:
+func (b httpBinding) pathFields() []string {
+	var fieldPaths []string
+	for _, match := range pathVariablePattern.FindAllStringSubmatch(b.path, -1) {
+		fieldPaths = append(fieldPaths, strings.TrimSpace(match[1]))
+	}
+	return fieldPaths
+}
+
+// Tells whether the path template of a binding captures a whole field of the request:
+func (b httpBinding) capturesField(fieldName string) bool {
+	for _, fieldPath := range b.pathFields() {
+		if fieldPath == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
+// Converts the HTTP bindings of a method into JSON-Schemas for their bodies ("<Service>.<Method>.body.jsonschema")
+// and query parameters ("<Service>.<Method>.query.jsonschema"). Fields which are captured by the path are left out of
+// both, and additional bindings are numbered ("<Service>.<Method>.1.body.jsonschema"):
+func (c *Converter) convertHTTPFiles(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, svc *descriptor.ServiceDescriptorProto, method *descriptor.MethodDescriptorProto) ([]*plugin.CodeGeneratorResponse_File, error) {
+	var response []*plugin.CodeGeneratorResponse_File
+	for i, binding := range c.httpBindings(method) {
+		bindingName := svc.GetName() + "." + method.GetName()
+		if i > 0 {
+			bindingName = fmt.Sprintf("%s.%d", bindingName, i)
+		}
+
+		// Everything which isn't captured by the path goes into the body when it's "*" (leaving no query parameters):
+		if binding.body != "" {
+			bodyFile, err := c.convertHTTPFile(pkg, file, method, binding, bindingName, "body")
+			if err != nil {
+				return nil, err
+			}
+			response = append(response, bodyFile)
+		}
+		if binding.body != "*" {
+			queryFile, err := c.convertHTTPFile(pkg, file, method, binding, bindingName, "query")
+			if err != nil {
+				return nil, err
+			}
+			if queryFile != nil {
+				response = append(response, queryFile)
+			}
+		}
+	}
+	return response, nil
+}
+
+// Converts the body or query parameters of an HTTP binding into a JSON-Schema file (there's no file for query
+// parameters when the path and body leave none):
+func (c *Converter) convertHTTPFile(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, method *descriptor.MethodDescriptorProto, binding httpBinding, bindingName, part string) (*plugin.CodeGeneratorResponse_File, error) {
+	protoFileName := path.Base(file.GetName())
+
+	recordType, _, ok := c.lookupType(pkg, method.GetInputType())
+	if !ok {
+		return nil, fmt.Errorf("no such message type named %s", method.GetInputType())
+	}
+	requestJSONSchema, err := c.convertMethodMessage(pkg, method.GetInputType(), c.methodFieldBehaviorVariant("request"))
+	if err != nil {
+		c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
+		return nil, err
+	}
+
+	// Fields captured by the path aren't part of the body or the query:
+	for _, fieldPath := range binding.pathFields() {
+		if err := c.removeFieldPath(pkg, recordType, requestJSONSchema, strings.Split(fieldPath, ".")); err != nil {
+			return nil, fmt.Errorf("invalid path %q of method %s: %v", binding.path, method.GetName(), err)
+		}
+	}
+
+	httpJSONSchema := requestJSONSchema
+	switch {
+
+	// The body can be a single field of the request:
+	case part == "body" && binding.body != "*":
+		bodyField := fieldByName(recordType, binding.body)
+		if bodyField == nil {
+			return nil, fmt.Errorf("no field named %s (the body of method %s) in %s", binding.body, method.GetName(), recordType.GetName())
+		}
+		var bodyJSONSchema interface{}
+		if requestJSONSchema.Properties != nil {
+			bodyJSONSchema, _ = requestJSONSchema.Properties.Get(bodyField.GetName())
+		}
+		if bodyJSONSchema == nil {
+			if binding.capturesField(binding.body) {
+				return nil, fmt.Errorf("the body of method %s (%s) is captured by its path", method.GetName(), binding.body)
+			}
+			return nil, fmt.Errorf("the body of method %s (%s) is excluded from its request (by its options, for being deprecated, or by its field behavior)", method.GetName(), binding.body)
+		}
+		bodyCopy := *bodyJSONSchema.(*jsonschema.Type)
+		httpJSONSchema = &bodyCopy
+
+	// Query parameters are whatever the body leaves:
+	case part == "query":
+		if bodyField := fieldByName(recordType, binding.body); bodyField != nil {
+			removeProperty(requestJSONSchema, c.propertyNames(bodyField))
+		}
+		if requestJSONSchema.Properties == nil || len(requestJSONSchema.Properties.Keys()) == 0 {
+			return nil, nil
+		}
+	}
+
+	jsonSchemaFileName, err := c.schemaFileName(file.GetPackage(), bindingName+"."+part)
+	if err != nil {
+		return nil, err
+	}
+	c.logger.WithField("proto_filename", protoFileName).WithField("method_name", bindingName).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for HTTP " + part)
+
+	httpJSONSchema.Version = c.schemaVersion()
+	if len(c.definitions) > 0 {
+		c.setDefinitions(httpJSONSchema, c.definitions)
+	}
+
+	// Title the schema after the binding, and describe it with the method's comments (if there are any):
+	httpJSONSchema.Title = fmt.Sprintf("%s %s (%s %s)", bindingName, part, binding.method, binding.path)
+	if src := c.sourceInfo.GetMethod(method); src != nil {
+		if description := formatDescription(src); description != "" {
+			httpJSONSchema.Description = description
+		}
+	}
+	setExtra(httpJSONSchema, "x-http-method", binding.method)
+	setExtra(httpJSONSchema, "x-http-path", binding.path)
+
+	return c.schemaFile(jsonSchemaFileName, httpJSONSchema)
+}
+
+// Removes a (dot-separated) field path from the schema of a message. Nested messages are copied before they're
+// changed, so that the definitions they may share with other fields stay intact:
+func (c *Converter) removeFieldPath(curPkg *ProtoPackage, msg *descriptor.DescriptorProto, jsonSchemaType *jsonschema.Type, fieldPath []string) error {
+	fieldDesc := fieldByName(msg, fieldPath[0])
+	if fieldDesc == nil {
+		return fmt.Errorf("no field named %s in %s", fieldPath[0], msg.GetName())
+	}
+	propertyNames := c.propertyNames(fieldDesc)
+	if len(fieldPath) == 1 {
+		removeProperty(jsonSchemaType, propertyNames)
+		return nil
+	}
+
+	recordType, _, ok := c.lookupType(curPkg, fieldDesc.GetTypeName())
+	if !ok || fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return fmt.Errorf("%s in %s isn't a singular message field", fieldPath[0], msg.GetName())
+	}
+	if jsonSchemaType.Properties == nil {
+		return fmt.Errorf("the schema of %s doesn't describe its fields", msg.GetName())
+	}
+	property, ok := jsonSchemaType.Properties.Get(fieldDesc.GetName())
+	if !ok {
+		return nil
+	}
+
+	// Nullable fields wrap the schema of their message in a "oneOf" with NULL (which is kept around the copy):
+	propertyJSONSchema := property.(*jsonschema.Type)
+	var wrapperJSONSchema *jsonschema.Type
+	if isNullableWrapper(propertyJSONSchema) {
+		wrapperJSONSchema, propertyJSONSchema = propertyJSONSchema, propertyJSONSchema.OneOf[1]
+	}
+
+	// Referenced messages are copied from their definitions:
+	nestedJSONSchema := *propertyJSONSchema
+	if definition, ok := c.definitions[strings.TrimPrefix(nestedJSONSchema.Ref, c.definitionsRefPrefix())]; ok && nestedJSONSchema.Ref != "" {
+		nestedJSONSchema = *definition
+	}
+	if nestedJSONSchema.Properties != nil {
+		properties := orderedmap.New()
+		for _, key := range nestedJSONSchema.Properties.Keys() {
+			value, _ := nestedJSONSchema.Properties.Get(key)
+			properties.Set(key, value)
+		}
+		nestedJSONSchema.Properties = properties
+	}
+	nestedJSONSchema.Required = append([]string(nil), nestedJSONSchema.Required...)
+	if err := c.removeFieldPath(curPkg, recordType, &nestedJSONSchema, fieldPath[1:]); err != nil {
+		return err
+	}
+
+	fieldJSONSchema := &nestedJSONSchema
+	if wrapperJSONSchema != nil {
+		wrapperCopy := *wrapperJSONSchema
+		wrapperCopy.OneOf = []*jsonschema.Type{wrapperJSONSchema.OneOf[0], &nestedJSONSchema}
+		fieldJSONSchema = &wrapperCopy
+	}
+	for _, propertyName := range propertyNames {
+		jsonSchemaType.Properties.Set(propertyName, fieldJSONSchema)
+	}
+	return nil
+}
+
+// The names of the properties which describe a field:
+func (c *Converter) propertyNames(fieldDesc *descriptor.FieldDescriptorProto) []string {
+	if c.UseProtoAndJSONFieldnames && fieldDesc.GetName() != fieldDesc.GetJsonName() {
+		return []string{fieldDesc.GetName(), fieldDesc.GetJsonName()}
+	}
+	return []string{fieldDesc.GetName()}
+}
+
+// Removes the properties of a field from a schema (and stops requiring them):
+func removeProperty(jsonSchemaType *jsonschema.Type, propertyNames []string) {
+	removed := make(map[string]bool)
+	for _, propertyName := range propertyNames {
+		removed[propertyName] = true
+		if jsonSchemaType.Properties != nil {
+			jsonSchemaType.Properties.Delete(propertyName)
+		}
+	}
+
+	var required []string
+	for _, propertyName := range jsonSchemaType.Required {
+		if !removed[propertyName] {
+			required = append(required, propertyName)
+		}
+	}
+	jsonSchemaType.Required = required
+
+	// Fields known by both of their names are required by an "anyOf" of their own:
+	var allOf []*jsonschema.Type
+	for _, subSchema := range jsonSchemaType.AllOf {
+		if len(subSchema.AnyOf) == 2 && len(subSchema.AnyOf[0].Required) == 1 && removed[subSchema.AnyOf[0].Required[0]] {
+			continue
+		}
+		allOf = append(allOf, subSchema)
+	}
+	jsonSchemaType.AllOf = allOf
+}
+
+// Finds a field of a message by its proto name:
+func fieldByName(msg *descriptor.DescriptorProto, name string) *descriptor.FieldDescriptorProto {
+	for _, fieldDesc := range msg.GetField() {
+		if fieldDesc.GetName() == name {
+			return fieldDesc
+		}
+	}
+	return nil
+}
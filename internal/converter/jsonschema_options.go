@@ -0,0 +1,145 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/sixt/protoc-gen-jsonschema/options"
+)
+
+// The options from jsonschema.proto (these return nil when they aren't set, and the getters of nil options return
+// their defaults):
+func getFieldOptions(fieldDesc *descriptor.FieldDescriptorProto) *options.FieldOptions {
+	if opts, err := proto.GetExtension(fieldDesc.GetOptions(), options.E_FieldOptions); err == nil {
+		return opts.(*options.FieldOptions)
+	}
+	return nil
+}
+
+func getMessageOptions(msg *descriptor.DescriptorProto) *options.MessageOptions {
+	if opts, err := proto.GetExtension(msg.GetOptions(), options.E_MessageOptions); err == nil {
+		return opts.(*options.MessageOptions)
+	}
+	return nil
+}
+
+func getEnumOptions(enum *descriptor.EnumDescriptorProto) *options.EnumOptions {
+	if opts, err := proto.GetExtension(enum.GetOptions(), options.E_EnumOptions); err == nil {
+		return opts.(*options.EnumOptions)
+	}
+	return nil
+}
+
+func getFileOptions(file *descriptor.FileDescriptorProto) *options.FileOptions {
+	if opts, err := proto.GetExtension(file.GetOptions(), options.E_FileOptions); err == nil {
+		return opts.(*options.FileOptions)
+	}
+	return nil
+}
+
+// Overrides the schema of a field with its field_options:
+func (c *Converter) applyFieldOptions(curPkg *ProtoPackage, fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) error {
+	fieldOptions := getFieldOptions(fieldDesc)
+	if fieldOptions == nil {
+		return nil
+	}
+
+	if err := setAnnotations(jsonSchemaType, fieldOptions.GetTitle(), fieldOptions.GetDescription(), fieldOptions.GetExamples()); err != nil {
+		return fmt.Errorf("invalid examples for field %s: %v", fieldDesc.GetName(), err)
+	}
+	if format := fieldOptions.GetFormat(); format != "" {
+		setFieldFormat(fieldDesc, jsonSchemaType, format)
+	}
+	if fieldOptions.GetReadOnly() {
+		setExtra(jsonSchemaType, "readOnly", true)
+	}
+
+	// Additional properties can only be changed for messages which are described inline (rather than referenced):
+	if fieldOptions.AdditionalProperties != nil && fieldDesc.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE && jsonSchemaType.Ref == "" {
+		recordType, _, ok := c.lookupType(curPkg, fieldDesc.GetTypeName())
+		if ok && !recordType.GetOptions().GetMapEntry() {
+			jsonSchemaType.AdditionalProperties = nil
+			c.setAdditionalProperties(jsonSchemaType, fieldOptions.GetAdditionalProperties())
+		}
+	}
+
+	return nil
+}
+
+// Sets the format of a field (which describes the items of repeated fields, as a copy in case they're shared):
+func setFieldFormat(fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, format string) {
+	if fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED && jsonSchemaType.Items != nil {
+		itemsJSONSchemaType := *jsonSchemaType.Items
+		itemsJSONSchemaType.Format = format
+		jsonSchemaType.Items = &itemsJSONSchemaType
+		return
+	}
+	jsonSchemaType.Format = format
+}
+
+// Overrides the schema of a message with its message_options:
+func applyMessageOptions(msg *descriptor.DescriptorProto, jsonSchemaType *jsonschema.Type) error {
+	messageOptions := getMessageOptions(msg)
+	if messageOptions == nil {
+		return nil
+	}
+
+	if err := setAnnotations(jsonSchemaType, messageOptions.GetTitle(), messageOptions.GetDescription(), messageOptions.GetExamples()); err != nil {
+		return fmt.Errorf("invalid examples for message %s: %v", msg.GetName(), err)
+	}
+	if messageOptions.GetReadOnly() {
+		setExtra(jsonSchemaType, "readOnly", true)
+	}
+	return nil
+}
+
+// Overrides the schema of an enum with its enum_options:
+func applyEnumOptions(enum *descriptor.EnumDescriptorProto, jsonSchemaType *jsonschema.Type) error {
+	enumOptions := getEnumOptions(enum)
+	if enumOptions == nil {
+		return nil
+	}
+
+	if err := setAnnotations(jsonSchemaType, enumOptions.GetTitle(), enumOptions.GetDescription(), enumOptions.GetExamples()); err != nil {
+		return fmt.Errorf("invalid examples for enum %s: %v", enum.GetName(), err)
+	}
+	return nil
+}
+
+// Decides whether a message allows properties which its schema doesn't describe (the message's options win over its
+// file's options, which win over the disallow_additional_properties parameter):
+func (c *Converter) allowsAdditionalProperties(msg *descriptor.DescriptorProto) bool {
+	if messageOptions := getMessageOptions(msg); messageOptions != nil && messageOptions.AdditionalProperties != nil {
+		return messageOptions.GetAdditionalProperties()
+	}
+	if fileOptions := getFileOptions(c.messageFiles[msg]); fileOptions != nil && fileOptions.AdditionalProperties != nil {
+		return fileOptions.GetAdditionalProperties()
+	}
+	return !c.DisallowAdditionalProperties
+}
+
+// Tells whether the options of a message (or its file) require all of its fields:
+func (c *Converter) requiresAllFields(msg *descriptor.DescriptorProto) bool {
+	return getMessageOptions(msg).GetRequired() || getFileOptions(c.messageFiles[msg]).GetRequired()
+}
+
+// Sets the title, description and examples of a schema (when they're given):
+func setAnnotations(jsonSchemaType *jsonschema.Type, title, description string, examples []string) error {
+	if title != "" {
+		jsonSchemaType.Title = title
+	}
+	if description != "" {
+		jsonSchemaType.Description = description
+	}
+	for _, example := range examples {
+		var value interface{}
+		if err := json.Unmarshal([]byte(example), &value); err != nil {
+			return fmt.Errorf("%q is not JSON: %v", example, err)
+		}
+		jsonSchemaType.Examples = append(jsonSchemaType.Examples, value)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// Returns the "propertyNames" constraint for the keys of a map (JSON object keys are always strings, so numbers are
+// constrained by patterns covering exactly the range of their proto type), or nil if any string will do:
+func mapKeyJSONSchemaType(keyDesc *descriptor.FieldDescriptorProto) *jsonschema.Type {
+	switch keyDesc.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return &jsonschema.Type{Enum: []interface{}{"true", "false"}}
+
+	case descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32:
+		return &jsonschema.Type{Pattern: signedIntegerPattern(math.MaxInt32)}
+
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return &jsonschema.Type{Pattern: signedIntegerPattern(math.MaxInt64)}
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return &jsonschema.Type{Pattern: fmt.Sprintf("^(0|%s)$", positiveIntegerPattern(math.MaxUint32))}
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return &jsonschema.Type{Pattern: fmt.Sprintf("^(0|%s)$", positiveIntegerPattern(math.MaxUint64))}
+
+	default:
+		return nil
+	}
+}
+
+// Constrains the keys of a map with "propertyNames", which only exists since draft-06. Draft-04 schemas match the keys
+// with "patternProperties" instead (and allow no other properties):
+func (c *Converter) setMapKeys(jsonSchemaType, keyJSONSchemaType, valueJSONSchemaType *jsonschema.Type) {
+	if c.draftAtLeast(Draft06) {
+		setExtra(jsonSchemaType, "propertyNames", keyJSONSchemaType)
+		return
+	}
+
+	keyPattern := keyJSONSchemaType.Pattern
+	if len(keyJSONSchemaType.Enum) > 0 {
+		var keys []string
+		for _, key := range keyJSONSchemaType.Enum {
+			keys = append(keys, regexp.QuoteMeta(fmt.Sprint(key)))
+		}
+		keyPattern = fmt.Sprintf("^(%s)$", strings.Join(keys, "|"))
+	}
+	jsonSchemaType.PatternProperties = map[string]*jsonschema.Type{keyPattern: valueJSONSchemaType}
+	jsonSchemaType.AdditionalProperties = []byte("false")
+}
+
+// Matches the decimal integers from -(max+1) to max (which is the range of two's complement integers):
+func signedIntegerPattern(max uint64) string {
+	return fmt.Sprintf("^(0|%s|-(%s))$", positiveIntegerPattern(max), positiveIntegerPattern(max+1))
+}
+
+// Matches the decimal integers from 1 to max (without leading zeros):
+func positiveIntegerPattern(max uint64) string {
+	digits := strconv.FormatUint(max, 10)
+
+	// Anything with fewer digits than the maximum:
+	var alternatives []string
+	switch len(digits) {
+	case 1:
+	case 2:
+		alternatives = append(alternatives, "[1-9]")
+	default:
+		alternatives = append(alternatives, fmt.Sprintf("[1-9][0-9]{0,%d}", len(digits)-2))
+	}
+
+	// Anything with as many digits, which has a smaller digit than the maximum after some common prefix:
+	for i := range digits {
+		lowest, highest := byte('0'), digits[i]-1
+		if i == 0 {
+			lowest = '1'
+		}
+		if highest < lowest {
+			continue
+		}
+
+		alternative := digits[:i] + digitClass(lowest, highest)
+		switch remaining := len(digits) - i - 1; remaining {
+		case 0:
+		case 1:
+			alternative += "[0-9]"
+		default:
+			alternative += fmt.Sprintf("[0-9]{%d}", remaining)
+		}
+		alternatives = append(alternatives, alternative)
+	}
+
+	// And the maximum itself:
+	alternatives = append(alternatives, digits)
+
+	return strings.Join(alternatives, "|")
+}
+
+// Matches a single digit between lowest and highest:
+func digitClass(lowest, highest byte) string {
+	if lowest == highest {
+		return string(lowest)
+	}
+	return fmt.Sprintf("[%c-%c]", lowest, highest)
+}
@@ -0,0 +1,212 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+	"gopkg.in/yaml.v3"
+)
+
+// OpenAPI documents are written into one file (whichever protos they're generated from):
+const (
+	openAPIVersion      = "3.1.0"
+	openAPIRefPrefix    = "#/components/schemas/"
+	openAPIYAMLFileName = "openapi.yaml"
+	openAPIJSONFileName = "openapi.json"
+)
+
+// An OpenAPI document which only describes "components.schemas":
+type openAPIDocument struct {
+	OpenAPI    string            `json:"openapi"`
+	Info       openAPIInfo       `json:"info"`
+	Components openAPIComponents `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas jsonschema.Definitions `json:"schemas"`
+}
+
+// Tells whether we're generating an OpenAPI document (rather than JSON-Schema files):
+func (c *Converter) generatesOpenAPI() bool {
+	return c.Output == OutputOpenAPI || c.Output == OutputOpenAPIJSON
+}
+
+// Converts every MESSAGE and ENUM of the given proto files into a single OpenAPI document. The schemas are keyed by
+// their fully-qualified names, along with any other types they refer to:
+func (c *Converter) convertOpenAPIFile(files []*descriptor.FileDescriptorProto) (*plugin.CodeGeneratorResponse_File, error) {
+	c.definitions = jsonschema.Definitions{}
+
+	var protoFileNames []string
+	for _, file := range files {
+		protoFileNames = append(protoFileNames, file.GetName())
+
+		// Files can opt out of generation altogether:
+		if getFileOptions(file).GetIgnore() {
+			c.logger.WithField("proto_filename", file.GetName()).Info("Ignoring file")
+			continue
+		}
+
+		pkg := c.registerPackage(file.Package)
+		for _, enum := range file.GetEnumType() {
+			if err := c.addOpenAPIEnum(file.GetPackage(), enum, enum.GetName()); err != nil {
+				return nil, err
+			}
+		}
+		for _, msg := range file.GetMessageType() {
+			if err := c.addOpenAPIMessage(pkg, file.GetPackage(), msg, msg.GetName()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	document := &openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:   strings.Join(protoFileNames, ", "),
+			Version: "0.0.0",
+		},
+		Components: openAPIComponents{
+			Schemas: c.definitions,
+		},
+	}
+
+	if c.Output == OutputOpenAPIJSON {
+		return c.schemaFile(openAPIJSONFileName, document)
+	}
+
+	// YAML is written by re-encoding the JSON (which keeps the order of the keywords):
+	documentJSON, err := json.Marshal(document)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to encode OpenAPI document")
+		return nil, err
+	}
+	documentYAML, err := jsonToYAML(documentJSON)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to encode OpenAPI document as YAML")
+		return nil, err
+	}
+	return &plugin.CodeGeneratorResponse_File{
+		Name:    proto.String(openAPIYAMLFileName),
+		Content: proto.String(string(documentYAML)),
+	}, nil
+}
+
+// Adds the schema of an ENUM (typeName is its name qualified by any enclosing messages):
+func (c *Converter) addOpenAPIEnum(pkgName string, enum *descriptor.EnumDescriptorProto, typeName string) error {
+	if getEnumOptions(enum).GetIgnore() {
+		return nil
+	}
+
+	definitionName := qualifiedName(pkgName, typeName)
+	c.logger.WithField("enum_name", definitionName).Info("Generating OpenAPI schema for ENUM")
+
+	// Enums which fields have already referred to are defined already:
+	_, err := c.enumDefinitionRef(enum, definitionName)
+	return err
+}
+
+// Adds the schema of a MESSAGE, and those of its nested messages and enums:
+func (c *Converter) addOpenAPIMessage(pkg *ProtoPackage, pkgName string, msg *descriptor.DescriptorProto, typeName string) error {
+	if msg.GetOptions().GetMapEntry() {
+		return nil
+	}
+
+	if !getMessageOptions(msg).GetIgnore() {
+		definitionName := qualifiedName(pkgName, typeName)
+		c.logger.WithField("msg_name", definitionName).Info("Generating OpenAPI schema for MESSAGE")
+
+		// Messages which have already been referred to are defined already:
+		if _, err := c.messageDefinitionRef(pkg, msg, "", definitionName); err != nil {
+			return err
+		}
+	}
+
+	for _, enum := range msg.GetEnumType() {
+		if err := c.addOpenAPIEnum(pkgName, enum, typeName+"."+enum.GetName()); err != nil {
+			return err
+		}
+	}
+	for _, nested := range msg.GetNestedType() {
+		if err := c.addOpenAPIMessage(pkg, pkgName, nested, typeName+"."+nested.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Qualifies the name of a type with its package (if it has one):
+func qualifiedName(pkgName, typeName string) string {
+	if pkgName == "" {
+		return typeName
+	}
+	return pkgName + "." + typeName
+}
+
+// Converts JSON into block-style YAML:
+func jsonToYAML(jsonData []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(jsonData, &node); err != nil {
+		return nil, err
+	}
+	setBlockStyle(&node)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Clears the JSON-style (flow collections and quoted strings) from YAML nodes, leaving the quoting of strings which
+// need it to the encoder:
+func setBlockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		setBlockStyle(child)
+	}
+}
+
+// Checks the parameters which the OpenAPI output depends on:
+func (c *Converter) checkOpenAPIParameters() error {
+	if !c.generatesOpenAPI() {
+		return nil
+	}
+	if c.Draft != "" && c.Draft != Draft202012 {
+		return fmt.Errorf("OpenAPI %s schemas are written in draft %s (not draft %s)", openAPIVersion, Draft202012, c.Draft)
+	}
+	if c.FileNaming != "" && c.FileNaming != FileNamingName {
+		return fmt.Errorf("file_naming doesn't apply to OpenAPI output (which is written to a single file)")
+	}
+
+	// The document only describes messages and enums (under their own names, and one schema per message):
+	if c.GenerateMethods {
+		return fmt.Errorf("generate_methods doesn't apply to OpenAPI output (which only describes messages and enums)")
+	}
+	if c.FieldBehaviorVariants {
+		return fmt.Errorf("field_behavior_variants doesn't apply to OpenAPI output (which has one schema per message)")
+	}
+	if c.GenerateAllTypes {
+		return fmt.Errorf("generate_all_types doesn't apply to OpenAPI output (which describes every message and enum anyway)")
+	}
+
+	// OpenAPI 3.1 schemas are draft 2020-12 (with "type" arrays for nullable values), and refer to each other:
+	c.Draft = Draft202012
+	c.UseRefs = true
+	return nil
+}
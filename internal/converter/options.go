@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Custom options (like protoc-gen-validate's rules) are extensions which we don't have generated code for, so protoc's
+// descriptors leave them as unknown fields. They can still be decoded with the extension declarations which come along
+// with the request (every file which uses an extension has to import the file declaring it):
+type protoOptions struct {
+	files *protoregistry.Files
+	types *protoregistry.Types
+}
+
+func newProtoOptions(fs []*descriptor.FileDescriptorProto) (*protoOptions, error) {
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(&descriptor.FileDescriptorSet{File: fs})
+	if err != nil {
+		return nil, err
+	}
+
+	// Register every extension declared in the request (top-level, or nested in messages):
+	types := new(protoregistry.Types)
+	var registerExtensions func(protoreflect.ExtensionDescriptors)
+	var registerMessages func(protoreflect.MessageDescriptors)
+	registerExtensions = func(extensions protoreflect.ExtensionDescriptors) {
+		for i := 0; i < extensions.Len(); i++ {
+			// Conflicts can only come from broken requests, and then the first declaration wins:
+			_ = types.RegisterExtension(dynamicpb.NewExtensionType(extensions.Get(i)))
+		}
+	}
+	registerMessages = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			registerExtensions(messages.Get(i).Extensions())
+			registerMessages(messages.Get(i).Messages())
+		}
+	}
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		registerExtensions(file.Extensions())
+		registerMessages(file.Messages())
+		return true
+	})
+
+	return &protoOptions{files: files, types: types}, nil
+}
+
+// Returns the value of an extension (by its full name) in some options, if it is set:
+func (o *protoOptions) extension(options proto.Message, name protoreflect.FullName) (protoreflect.Value, bool) {
+	if o == nil || !options.ProtoReflect().IsValid() {
+		return protoreflect.Value{}, false
+	}
+	extensionType, err := o.types.FindExtensionByName(name)
+	if err != nil {
+		return protoreflect.Value{}, false
+	}
+
+	// Decode the options again, this time with the extensions we know about:
+	optionsDescriptor, err := o.files.FindDescriptorByName(options.ProtoReflect().Descriptor().FullName())
+	if err != nil {
+		return protoreflect.Value{}, false
+	}
+	optionsMessageDescriptor, ok := optionsDescriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return protoreflect.Value{}, false
+	}
+	encoded, err := proto.Marshal(options)
+	if err != nil {
+		return protoreflect.Value{}, false
+	}
+	decoded := dynamicpb.NewMessage(optionsMessageDescriptor)
+	if err := (proto.UnmarshalOptions{Resolver: o.types}).Unmarshal(encoded, decoded); err != nil {
+		return protoreflect.Value{}, false
+	}
+
+	if !decoded.Has(extensionType.TypeDescriptor()) {
+		return protoreflect.Value{}, false
+	}
+	return decoded.Get(extensionType.TypeDescriptor()), true
+}
@@ -12,6 +12,7 @@ type ProtoPackage struct {
 	parent   *ProtoPackage
 	children map[string]*ProtoPackage
 	types    map[string]*descriptor.DescriptorProto
+	enums    map[string]*descriptor.EnumDescriptorProto
 }
 
 func (c *Converter) lookupType(pkg *ProtoPackage, name string) (*descriptor.DescriptorProto, string, bool) {
@@ -54,6 +55,62 @@ func (c *Converter) relativelyLookupType(pkg *ProtoPackage, name string) (*descr
 	}
 }
 
+func (c *Converter) lookupEnum(pkg *ProtoPackage, name string) (*descriptor.EnumDescriptorProto, bool) {
+	if strings.HasPrefix(name, ".") {
+		return c.relativelyLookupEnum(globalPkg, name[1:len(name)])
+	}
+
+	for ; pkg != nil; pkg = pkg.parent {
+		if desc, ok := c.relativelyLookupEnum(pkg, name); ok {
+			return desc, ok
+		}
+	}
+	return nil, false
+}
+
+func (c *Converter) relativelyLookupEnum(pkg *ProtoPackage, name string) (*descriptor.EnumDescriptorProto, bool) {
+	components := strings.SplitN(name, ".", 2)
+	switch len(components) {
+	case 0:
+		c.logger.Debug("empty enum name")
+		return nil, false
+	case 1:
+		found, ok := pkg.enums[components[0]]
+		return found, ok
+	case 2:
+		c.logger.Tracef("Looking for enum %s in %s at %s (%v)", components[1], components[0], pkg.name, pkg)
+		if child, ok := pkg.children[components[0]]; ok {
+			return c.relativelyLookupEnum(child, components[1])
+		}
+		if msg, ok := pkg.types[components[0]]; ok {
+			return c.relativelyLookupNestedEnum(msg, components[1])
+		}
+		c.logger.WithField("component", components[0]).WithField("package_name", pkg.name).Info("No such package nor message in package")
+		return nil, false
+	default:
+		c.logger.Error("Failed to lookup enum")
+		return nil, false
+	}
+}
+
+func (c *Converter) relativelyLookupNestedEnum(desc *descriptor.DescriptorProto, name string) (*descriptor.EnumDescriptorProto, bool) {
+	// Everything before the last component names the (nested) message which declares the enum:
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		var ok bool
+		if desc, ok = c.relativelyLookupNestedType(desc, name[:i]); !ok {
+			return nil, false
+		}
+		name = name[i+1:]
+	}
+	for _, enum := range desc.GetEnumType() {
+		if enum.GetName() == name {
+			return enum, true
+		}
+	}
+	c.logger.WithField("enum_name", name).WithField("description", desc.GetName()).Info("no such nested enum")
+	return nil, false
+}
+
 func (c *Converter) relativelyLookupPackage(pkg *ProtoPackage, name string) (*ProtoPackage, bool) {
 	components := strings.Split(name, ".")
 	for _, c := range components {
@@ -0,0 +1,268 @@
+package converter
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Patterns for the strings which protojson accepts in place of numbers. Integers can be written as any number which
+// is whole ("1.5e1" is 15, and "100e-2" is 1), within their range. Patterns never match a string which protojson
+// rejects, but as they can't count digits, they miss some unusual ones which it reads: integers followed by more than
+// protojsonMaxDigits zeros which a negative exponent takes away, floats with more than protojsonMaxFloatDigits digits
+// before a positive exponent (or more than protojsonMaxDigits zeros after "0."), or more digits than their largest
+// value before a negative one, and floats whose first digits are those of the limit they'd overflow at. protojson also
+// ignores anything after a number which is followed by a delimiter (reading "1 x" as 1), which patterns don't match:
+var (
+	protojsonDoublePattern = floatStringPattern(1023, 53)
+	protojsonFloatPattern  = floatStringPattern(127, 24)
+	protojsonInt32Pattern  = integerStringPattern(math.MaxInt32, true)
+	protojsonUint32Pattern = integerStringPattern(math.MaxUint32, false)
+	protojsonInt64Pattern  = integerStringPattern(math.MaxInt64, true)
+	protojsonUint64Pattern = integerStringPattern(math.MaxUint64, false)
+)
+
+const (
+	// The most digits protojson reads an integer with (those of the largest 64-bit integer), which is also as far as it
+	// lets a positive exponent shift them:
+	protojsonMaxDigits = 20
+
+	// The most digits floats match with before a positive exponent:
+	protojsonMaxFloatDigits = 40
+)
+
+// Matches the strings which protojson reads as integers from 0 to max, and as negative integers down to -(max+1) if
+// they're signed. Unsigned integers only take "-0":
+func integerStringPattern(max uint64, signed bool) string {
+	alternatives := []string{`-?0(\.0+)?([eE][+-]?[0-9]+)?`, wholeNumberPattern(max)}
+	if signed {
+		alternatives = append(alternatives, "-("+wholeNumberPattern(max+1)+")")
+	}
+	return "^(" + strings.Join(alternatives, "|") + ")$"
+}
+
+// Matches the ways JSON can write the integers from 1 to max:
+func wholeNumberPattern(max uint64) string {
+	// The integer itself (with a fraction of zeros, or an exponent of zero), or followed by zeros which a negative
+	// exponent takes away again:
+	shifts := []string{`(\.0+)?([eE][+-]?0+)?`}
+	for shift := 1; shift <= protojsonMaxDigits; shift++ {
+		shifts = append(shifts, fmt.Sprintf(`0{%d}(\.0+)?[eE]-0*%d`, shift, shift))
+	}
+	alternatives := []string{fmt.Sprintf("(%s)(%s)", positiveIntegerPattern(max), strings.Join(shifts, "|"))}
+
+	// A fraction which a positive exponent shifts into a whole number:
+	for shift := 1; shift <= protojsonMaxDigits; shift++ {
+		alternatives = append(alternatives, fmt.Sprintf(`(%s)[eE]\+?0*%d`, shiftedWholeNumberPattern(strconv.FormatUint(max, 10), shift), shift))
+	}
+
+	return strings.Join(alternatives, "|")
+}
+
+// Matches the numbers (with up to shift digits in their fraction) which are whole numbers from 0 to max once they're
+// multiplied by 10^shift:
+func shiftedWholeNumberPattern(maxDigits string, shift int) string {
+	fraction := fmt.Sprintf(`(\.[0-9]{1,%d}0*)?`, shift)
+
+	// Split the maximum where the decimal point goes:
+	integerDigits, fractionDigits := "0", maxDigits
+	if shift < len(maxDigits) {
+		integerDigits, fractionDigits = maxDigits[:len(maxDigits)-shift], maxDigits[len(maxDigits)-shift:]
+	} else {
+		fractionDigits = strings.Repeat("0", shift-len(maxDigits)) + maxDigits
+	}
+
+	// Anything below the integer part of the maximum can have any fraction, and the integer part itself can only have
+	// a fraction up to the maximum's:
+	var alternatives []string
+	if integerMax, _ := strconv.ParseUint(integerDigits, 10, 64); integerMax > 1 {
+		alternatives = append(alternatives, fmt.Sprintf("(0|%s)%s", positiveIntegerPattern(integerMax-1), fraction))
+	} else if integerMax == 1 {
+		alternatives = append(alternatives, "0"+fraction)
+	}
+	alternatives = append(alternatives, fmt.Sprintf(`%s(\.(%s)0*)?`, integerDigits, fractionUpToPattern(fractionDigits)))
+
+	return strings.Join(alternatives, "|")
+}
+
+// Matches the (non-empty) fractions which are no larger than the given one:
+func fractionUpToPattern(maxDigits string) string {
+	var alternatives []string
+	if maxDigits[0] > '0' {
+		alternative := digitClass('0', maxDigits[0]-1)
+		if len(maxDigits) > 1 {
+			alternative += fmt.Sprintf("[0-9]{0,%d}", len(maxDigits)-1)
+		}
+		alternatives = append(alternatives, alternative)
+	}
+	alternative := maxDigits[:1]
+	if len(maxDigits) > 1 {
+		alternative += "(" + fractionUpToPattern(maxDigits[1:]) + ")?"
+	}
+	return strings.Join(append(alternatives, alternative), "|")
+}
+
+// Matches the strings which protojson reads as finite floats with the given largest exponent and precision (along with
+// "NaN" and "Infinity"). Floats overflow from halfway between the largest one and the next power of two. Numbers below
+// the decade of that match with up to protojsonMaxFloatDigits digits before an exponent. Within it, their digits have
+// to be lower than the first ones of the overflow, of which there are as many as the longest shortest spelling of a
+// float has (so that the largest float matches however it's written):
+func floatStringPattern(maxExponent, precision int) string {
+	overflow := new(big.Int).Lsh(big.NewInt(1), uint(maxExponent+1))
+	overflow.Sub(overflow, new(big.Int).Lsh(big.NewInt(1), uint(maxExponent-precision)))
+	overflowDigits := overflow.String()
+	maxDecade := len(overflowDigits) - 1
+	limitDigits := overflowDigits[:int(math.Ceil(float64(precision)*math.Log10(2)))+1]
+
+	alternatives := []string{
+		// Zero (with any exponent), and numbers below the decade of the overflow which no exponent shifts up:
+		`0(\.0+)?([eE][+-]?[0-9]+)?`,
+		fmt.Sprintf(`(0|[1-9][0-9]{0,%d})(\.[0-9]+)?([eE](-[0-9]+|\+?0+))?`, maxDecade-1),
+	}
+
+	// Numbers which an exponent shifts into the lower decades, and fractions (after "0.") which it shifts into those or
+	// into the decade of the overflow:
+	for integerDigits := 1; integerDigits <= protojsonMaxFloatDigits && integerDigits <= maxDecade; integerDigits++ {
+		integerPattern := "[1-9]"
+		if integerDigits > 1 {
+			integerPattern += fmt.Sprintf("[0-9]{%d}", integerDigits-1)
+		}
+		alternatives = append(alternatives, fmt.Sprintf(`%s(\.[0-9]+)?[eE]\+?0*(%s)`, integerPattern, upToPattern(maxDecade-integerDigits)))
+	}
+	for zeros := 0; zeros <= protojsonMaxDigits; zeros++ {
+		alternatives = append(alternatives, fmt.Sprintf(`0\.0{%d}([0-9]+[eE]\+?0*(%s)|(%s)[eE]\+?0*%d)`, zeros, upToPattern(maxDecade+zeros), fractionBelowPattern(limitDigits), maxDecade+zeros+1))
+	}
+
+	// Numbers in the decade of the overflow, with up to as many integer digits as it has first digits, or with more
+	// after integer digits which are lower than those:
+	for integerDigits := 1; integerDigits <= len(limitDigits); integerDigits++ {
+		alternatives = append(alternatives, fmt.Sprintf(`(%s)[eE]\+?0*%d`, digitsBelowPattern(limitDigits, integerDigits), maxDecade-integerDigits+1))
+	}
+	limit, _ := strconv.ParseUint(limitDigits, 10, 64)
+	shifts := []string{fmt.Sprintf(`[0-9]{%d}(\.[0-9]+)?([eE][+-]?0+)?`, maxDecade+1-len(limitDigits))}
+	for moreDigits := 1; len(limitDigits)+moreDigits <= protojsonMaxFloatDigits && len(limitDigits)+moreDigits <= maxDecade; moreDigits++ {
+		shifts = append(shifts, fmt.Sprintf(`[0-9]{%d}(\.[0-9]+)?[eE]\+?0*%d`, moreDigits, maxDecade-len(limitDigits)-moreDigits+1))
+	}
+	alternatives = append(alternatives, fmt.Sprintf("(%s)(%s)", positiveIntegerPattern(limit-1), strings.Join(shifts, "|")))
+
+	return fmt.Sprintf("^(NaN|-?Infinity|-?(%s))$", strings.Join(alternatives, "|"))
+}
+
+// Matches the integers from 0 to max:
+func upToPattern(max int) string {
+	if max == 0 {
+		return "0"
+	}
+	return "0|" + positiveIntegerPattern(uint64(max))
+}
+
+// Matches the numbers with the given number of integer digits whose digits are lower than the given ones. A number
+// which stops at one of the given digits counts as lower (the limits these are taken from go on), as long as it has all
+// of its integer digits:
+func digitsBelowPattern(digits string, integerDigits int) string {
+	return digitsBelowFrom(digits, integerDigits, 0)
+}
+
+// Matches the rest of a number for digitsBelowPattern, from its digit at the given position on (or nothing, if it
+// can't go on):
+func digitsBelowFrom(digits string, integerDigits, position int) string {
+	if position == len(digits) {
+		return ""
+	}
+	point := ""
+	if position == integerDigits {
+		point = `\.`
+	}
+
+	// A lower digit can be followed by any others, while the same digit has to go on being lower:
+	var alternatives []string
+	lowest := byte('0')
+	if position == 0 {
+		lowest = '1'
+	}
+	if digits[position] > lowest {
+		rest := "[0-9]*"
+		if position < integerDigits {
+			rest = `(\.[0-9]+)?`
+			if remaining := integerDigits - position - 1; remaining > 0 {
+				rest = fmt.Sprintf("[0-9]{%d}", remaining) + rest
+			}
+		}
+		alternatives = append(alternatives, point+digitClass(lowest, digits[position]-1)+rest)
+	}
+	next := digitsBelowFrom(digits, integerDigits, position+1)
+	switch {
+	case position+1 < integerDigits && next != "":
+		alternatives = append(alternatives, fmt.Sprintf("%s%c(%s)", point, digits[position], next))
+	case position+1 >= integerDigits && next != "":
+		alternatives = append(alternatives, fmt.Sprintf("%s%c(%s)?", point, digits[position], next))
+	case position+1 >= integerDigits:
+		alternatives = append(alternatives, fmt.Sprintf("%s%c", point, digits[position]))
+	}
+	return strings.Join(alternatives, "|")
+}
+
+// Matches the (non-empty) fractions which are lower than the given one (which doesn't end in a zero):
+func fractionBelowPattern(digits string) string {
+	var alternatives []string
+	if digits[0] > '0' {
+		alternatives = append(alternatives, digitClass('0', digits[0]-1)+"[0-9]*")
+	}
+	if len(digits) > 1 {
+		alternatives = append(alternatives, fmt.Sprintf("%c(%s)?", digits[0], fractionBelowPattern(digits[1:])))
+	}
+	return strings.Join(alternatives, "|")
+}
+
+// Sets the types of a numeric field, which protojson also accepts as a string (in protojson_compat mode):
+func (c *Converter) setNumberTypes(jsonSchemaType *jsonschema.Type, jsonType, stringPattern string) {
+	if !c.ProtojsonCompat {
+		c.setTypes(jsonSchemaType, c.withNull(jsonType)...)
+		return
+	}
+
+	c.setTypes(jsonSchemaType, c.withNull(jsonType, gojsonschema.TYPE_STRING)...)
+	jsonSchemaType.Pattern = stringPattern
+}
+
+// Returns the JSON-Schema for a simple WKT, taking the number encodings of protojson_compat mode into account:
+func (c *Converter) wellKnownType(name string) *jsonschema.Type {
+	if !c.ProtojsonCompat {
+		return wellKnownTypes[name]
+	}
+
+	jsonSchemaType := &jsonschema.Type{}
+	switch name {
+	case "DoubleValue":
+		c.setTypes(jsonSchemaType, gojsonschema.TYPE_NUMBER, gojsonschema.TYPE_STRING)
+		jsonSchemaType.Pattern = protojsonDoublePattern
+	case "FloatValue":
+		c.setTypes(jsonSchemaType, gojsonschema.TYPE_NUMBER, gojsonschema.TYPE_STRING)
+		jsonSchemaType.Pattern = protojsonFloatPattern
+	case "Int32Value":
+		c.setTypes(jsonSchemaType, gojsonschema.TYPE_INTEGER, gojsonschema.TYPE_STRING)
+		jsonSchemaType.Pattern = protojsonInt32Pattern
+		setRange(jsonSchemaType, math.MinInt32, math.MaxInt32)
+	case "Int64Value":
+		c.setTypes(jsonSchemaType, gojsonschema.TYPE_INTEGER, gojsonschema.TYPE_STRING)
+		jsonSchemaType.Pattern = protojsonInt64Pattern
+		setRange(jsonSchemaType, math.MinInt64, math.MaxInt64)
+	case "UInt32Value":
+		c.setTypes(jsonSchemaType, gojsonschema.TYPE_INTEGER, gojsonschema.TYPE_STRING)
+		jsonSchemaType.Pattern = protojsonUint32Pattern
+		setRange(jsonSchemaType, 0, uint32(math.MaxUint32))
+	case "UInt64Value":
+		c.setTypes(jsonSchemaType, gojsonschema.TYPE_INTEGER, gojsonschema.TYPE_STRING)
+		jsonSchemaType.Pattern = protojsonUint64Pattern
+		setRange(jsonSchemaType, 0, uint64(math.MaxUint64))
+	default:
+		return wellKnownTypes[name]
+	}
+
+	return jsonSchemaType
+}
@@ -0,0 +1,107 @@
+package converter
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+// Converts each method of a SERVICE into a JSON-Schema file for its request, and one for its response
+// ("<Service>.<Method>.request.jsonschema" and "<Service>.<Method>.response.jsonschema"):
+func (c *Converter) convertServiceFiles(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, svc *descriptor.ServiceDescriptorProto) ([]*plugin.CodeGeneratorResponse_File, error) {
+	var response []*plugin.CodeGeneratorResponse_File
+	for _, method := range svc.GetMethod() {
+		requestFile, err := c.convertMethodFile(pkg, file, svc, method, "request", method.GetInputType(), method.GetClientStreaming())
+		if err != nil {
+			return nil, err
+		}
+		responseFile, err := c.convertMethodFile(pkg, file, svc, method, "response", method.GetOutputType(), method.GetServerStreaming())
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, requestFile, responseFile)
+
+		// Methods which are transcoded from HTTP get schemas for their HTTP requests too:
+		httpFiles, err := c.convertHTTPFiles(pkg, file, svc, method)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, httpFiles...)
+	}
+	return response, nil
+}
+
+// Converts the request or response MESSAGE of a method into a JSON-Schema file. Streams of messages are marked with
+// "x-streaming" (each message in the stream is validated against the schema on its own):
+func (c *Converter) convertMethodFile(pkg *ProtoPackage, file *descriptor.FileDescriptorProto, svc *descriptor.ServiceDescriptorProto, method *descriptor.MethodDescriptorProto, direction, typeName string, streaming bool) (*plugin.CodeGeneratorResponse_File, error) {
+	protoFileName := path.Base(file.GetName())
+	methodName := svc.GetName() + "." + method.GetName()
+
+	jsonSchemaFileName, err := c.schemaFileName(file.GetPackage(), methodName+"."+direction)
+	if err != nil {
+		return nil, err
+	}
+	c.logger.WithField("proto_filename", protoFileName).WithField("method_name", methodName).WithField("jsonschema_filename", jsonSchemaFileName).Info("Generating JSON-schema for METHOD " + direction)
+
+	methodJSONSchema, err := c.convertMethodMessage(pkg, typeName, c.methodFieldBehaviorVariant(direction))
+	if err != nil {
+		c.logger.WithError(err).WithField("proto_filename", protoFileName).Error("Failed to convert")
+		return nil, err
+	}
+	methodJSONSchema.Version = c.schemaVersion()
+
+	if len(c.definitions) > 0 {
+		c.setDefinitions(methodJSONSchema, c.definitions)
+	}
+
+	// Title the schema after the method, and describe it with the method's comments (if there are any):
+	methodJSONSchema.Title = fmt.Sprintf("%s %s", methodName, direction)
+	if src := c.sourceInfo.GetMethod(method); src != nil {
+		if description := formatDescription(src); description != "" {
+			methodJSONSchema.Description = description
+		}
+	}
+
+	if streaming {
+		setExtra(methodJSONSchema, "x-streaming", true)
+	}
+
+	return c.schemaFile(jsonSchemaFileName, methodJSONSchema)
+}
+
+// Converts the request or response MESSAGE of a method (collecting any definitions it refers to), optionally as one of
+// its field behavior variants:
+func (c *Converter) convertMethodMessage(pkg *ProtoPackage, typeName, variant string) (*jsonschema.Type, error) {
+	recordType, pkgName, ok := c.lookupType(pkg, typeName)
+	if !ok {
+		return nil, fmt.Errorf("no such message type named %s", typeName)
+	}
+	c.fieldBehaviorVariant = variant
+	defer func() { c.fieldBehaviorVariant = "" }()
+
+	// Well-known types can be NULL as fields, but not as whole requests or responses (and their schemas are shared, so
+	// they're copied before they get a "$schema" of their own):
+	c.definitions = jsonschema.Definitions{}
+	if isSpecialWellKnownType(pkgName, recordType) {
+		wellKnownJSONSchema, err := c.convertWellKnownType(recordType.GetName())
+		if err != nil {
+			return nil, err
+		}
+		methodJSONSchema := *wellKnownJSONSchema
+		methodJSONSchema.Extras = make(map[string]interface{})
+		for keyword, value := range wellKnownJSONSchema.Extras {
+			methodJSONSchema.Extras[keyword] = value
+		}
+		return &methodJSONSchema, nil
+	}
+
+	methodJSONSchema, err := c.convertMessageType(pkg, recordType, pkgName)
+	if err != nil {
+		return nil, err
+	}
+
+	return methodJSONSchema, nil
+}
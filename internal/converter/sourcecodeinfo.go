@@ -11,11 +11,13 @@ import (
 const (
 	tag_FileDescriptor_messageType int32 = 4
 	tag_FileDescriptor_enumType    int32 = 5
+	tag_FileDescriptor_service     int32 = 6
 	tag_Descriptor_field           int32 = 2
 	tag_Descriptor_nestedType      int32 = 3
 	tag_Descriptor_enumType        int32 = 4
 	tag_Descriptor_oneofDecl       int32 = 8
 	tag_EnumDescriptor_value       int32 = 2
+	tag_ServiceDescriptor_method   int32 = 2
 )
 
 type sourceCodeInfo struct {
@@ -30,6 +32,10 @@ func (s sourceCodeInfo) GetField(f *descriptor.FieldDescriptorProto) *descriptor
 	return s.lookup[f]
 }
 
+func (s sourceCodeInfo) GetOneof(o *descriptor.OneofDescriptorProto) *descriptor.SourceCodeInfo_Location {
+	return s.lookup[o]
+}
+
 func (s sourceCodeInfo) GetEnum(e *descriptor.EnumDescriptorProto) *descriptor.SourceCodeInfo_Location {
 	return s.lookup[e]
 }
@@ -38,6 +44,10 @@ func (s sourceCodeInfo) GetEnumValue(e *descriptor.EnumValueDescriptorProto) *de
 	return s.lookup[e]
 }
 
+func (s sourceCodeInfo) GetMethod(m *descriptor.MethodDescriptorProto) *descriptor.SourceCodeInfo_Location {
+	return s.lookup[m]
+}
+
 func newSourceCodeInfo(fs []*descriptor.FileDescriptorProto) *sourceCodeInfo {
 	// For each source location in the provided files
 	// - resolve the (annoyingly) encoded path to its message/field/service/enum/etc definition
@@ -78,6 +88,9 @@ func getDefinitionAtPath(file *descriptor.FileDescriptorProto, path []int32) pro
 			case tag_FileDescriptor_enumType:
 				step++
 				pos = p.EnumType[path[step]]
+			case tag_FileDescriptor_service:
+				step++
+				pos = p.Service[path[step]]
 			default:
 				return nil // ignore all other types
 			}
@@ -109,6 +122,15 @@ func getDefinitionAtPath(file *descriptor.FileDescriptorProto, path []int32) pro
 				return nil // ignore all other types
 			}
 
+		case *descriptor.ServiceDescriptorProto:
+			switch path[step] {
+			case tag_ServiceDescriptor_method:
+				step++
+				pos = p.Method[path[step]]
+			default:
+				return nil // ignore all other types
+			}
+
 		default:
 			return nil // ignore all other types
 		}
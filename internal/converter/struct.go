@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Names of the shared definitions for google.protobuf.Struct and friends:
+const (
+	listValueDefinitionName = "google.protobuf.ListValue"
+	structDefinitionName    = "google.protobuf.Struct"
+	valueDefinitionName     = "google.protobuf.Value"
+)
+
+// Returns a "$ref" to Struct, ListValue or Value. These describe arbitrary JSON in terms of each other, so all three of
+// them are added to the shared definitions together:
+func (c *Converter) structDefinitionRef(definitionName string) (*jsonschema.Type, error) {
+	ref := &jsonschema.Type{Ref: c.definitionsRefPrefix() + definitionName}
+	if _, ok := c.definitions[valueDefinitionName]; ok {
+		return ref, nil
+	}
+
+	listValueRef := &jsonschema.Type{Ref: c.definitionsRefPrefix() + listValueDefinitionName}
+	structRef := &jsonschema.Type{Ref: c.definitionsRefPrefix() + structDefinitionName}
+	valueRefJSON, err := json.Marshal(&jsonschema.Type{Ref: c.definitionsRefPrefix() + valueDefinitionName})
+	if err != nil {
+		return nil, err
+	}
+
+	// A Struct is an object of Values:
+	c.definitions[structDefinitionName] = &jsonschema.Type{
+		Type:                 gojsonschema.TYPE_OBJECT,
+		AdditionalProperties: valueRefJSON,
+	}
+
+	// A ListValue is an array of Values:
+	c.definitions[listValueDefinitionName] = &jsonschema.Type{
+		Type:  gojsonschema.TYPE_ARRAY,
+		Items: &jsonschema.Type{Ref: c.definitionsRefPrefix() + valueDefinitionName},
+	}
+
+	// A Value is any JSON value at all:
+	c.definitions[valueDefinitionName] = &jsonschema.Type{
+		OneOf: []*jsonschema.Type{
+			{Type: gojsonschema.TYPE_NULL},
+			{Type: gojsonschema.TYPE_NUMBER},
+			{Type: gojsonschema.TYPE_STRING},
+			{Type: gojsonschema.TYPE_BOOLEAN},
+			structRef,
+			listValueRef,
+		},
+	}
+
+	return ref, nil
+}
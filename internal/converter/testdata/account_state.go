@@ -0,0 +1,113 @@
+package testdata
+
+const AccountStateNames = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "ACCOUNT_STATE_UNKNOWN",
+        "ACCOUNT_STATE_ACTIVE",
+        "ACCOUNT_STATE_SUSPENDED"
+    ],
+    "type": "string",
+    "description": "The state of an account:"
+}`
+
+const AccountStateNumbers = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        0,
+        1,
+        2
+    ],
+    "type": "integer",
+    "description": "The state of an account:"
+}`
+
+const AccountStateOneOf = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "ACCOUNT_STATE_UNKNOWN",
+        0,
+        "ACCOUNT_STATE_ACTIVE",
+        1,
+        "ACCOUNT_STATE_SUSPENDED",
+        2
+    ],
+    "oneOf": [
+        {
+            "enum": [
+                "ACCOUNT_STATE_UNKNOWN",
+                0
+            ],
+            "title": "ACCOUNT_STATE_UNKNOWN"
+        },
+        {
+            "enum": [
+                "ACCOUNT_STATE_ACTIVE",
+                1
+            ],
+            "title": "ACCOUNT_STATE_ACTIVE",
+            "description": "The account can be used"
+        },
+        {
+            "enum": [
+                "ACCOUNT_STATE_SUSPENDED",
+                2
+            ],
+            "title": "ACCOUNT_STATE_SUSPENDED",
+            "description": "The account has been suspended by an administrator"
+        }
+    ],
+    "description": "The state of an account:",
+    "x-enum-descriptions": [
+        "",
+        "",
+        "The account can be used",
+        "The account can be used",
+        "The account has been suspended by an administrator",
+        "The account has been suspended by an administrator"
+    ],
+    "x-enumNames": [
+        "ACCOUNT_STATE_UNKNOWN",
+        "ACCOUNT_STATE_UNKNOWN",
+        "ACCOUNT_STATE_ACTIVE",
+        "ACCOUNT_STATE_ACTIVE",
+        "ACCOUNT_STATE_SUSPENDED",
+        "ACCOUNT_STATE_SUSPENDED"
+    ]
+}`
+
+const AccountStateOneOfNamesDraft07 = `{
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "enum": [
+        "ACCOUNT_STATE_UNKNOWN",
+        "ACCOUNT_STATE_ACTIVE",
+        "ACCOUNT_STATE_SUSPENDED"
+    ],
+    "oneOf": [
+        {
+            "title": "ACCOUNT_STATE_UNKNOWN",
+            "const": "ACCOUNT_STATE_UNKNOWN"
+        },
+        {
+            "title": "ACCOUNT_STATE_ACTIVE",
+            "description": "The account can be used",
+            "const": "ACCOUNT_STATE_ACTIVE"
+        },
+        {
+            "title": "ACCOUNT_STATE_SUSPENDED",
+            "description": "The account has been suspended by an administrator",
+            "const": "ACCOUNT_STATE_SUSPENDED"
+        }
+    ],
+    "description": "The state of an account:",
+    "x-enum-descriptions": [
+        "",
+        "The account can be used",
+        "The account has been suspended by an administrator"
+    ],
+    "x-enumNames": [
+        "ACCOUNT_STATE_UNKNOWN",
+        "ACCOUNT_STATE_ACTIVE",
+        "ACCOUNT_STATE_SUSPENDED"
+    ]
+}`
@@ -17,7 +17,9 @@ const ArrayOfMessages = `{
                         "type": "string"
                     },
                     "id": {
-                        "type": "integer"
+                        "type": "integer",
+                        "maximum": 2147483647,
+                        "minimum": -2147483648
                     },
                     "rating": {
                         "type": "number"
@@ -45,7 +45,9 @@ const ArrayOfObjects = `{
                             {
                                 "type": "integer"
                             }
-                        ]
+                        ],
+                        "maximum": 2147483647,
+                        "minimum": -2147483648
                     },
                     "rating": {
                         "oneOf": [
@@ -80,7 +82,8 @@ const ArrayOfObjects = `{
                             "ARRAY_OF_OBJECT",
                             4,
                             "ARRAY_OF_MESSAGE",
-                            5
+                            5,
+                            null
                         ],
                         "oneOf": [
                             {
@@ -22,7 +22,9 @@ const ArrayOfPrimitives = `{
                     {
                         "type": "integer"
                     }
-                ]
+                ],
+                "maximum": 2147483647,
+                "minimum": -2147483648
             },
             "oneOf": [
                 {
@@ -35,6 +37,7 @@ const ArrayOfPrimitives = `{
         },
         "luckyBigNumbers": {
             "items": {
+                "pattern": "^-?[0-9]+$",
                 "oneOf": [
                     {
                         "type": "integer"
@@ -45,7 +48,9 @@ const ArrayOfPrimitives = `{
                     {
                         "type": "null"
                     }
-                ]
+                ],
+                "maximum": 9223372036854775807,
+                "minimum": -9223372036854775808
             },
             "oneOf": [
                 {
@@ -77,6 +82,7 @@ const ArrayOfPrimitives = `{
             ]
         },
         "big_number": {
+            "pattern": "^-?[0-9]+$",
             "oneOf": [
                 {
                     "type": "integer"
@@ -87,7 +93,9 @@ const ArrayOfPrimitives = `{
                 {
                     "type": "null"
                 }
-            ]
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
         }
     },
     "additionalProperties": true,
@@ -123,7 +131,9 @@ const ArrayOfPrimitivesDouble = `{
                     {
                         "type": "integer"
                     }
-                ]
+                ],
+                "maximum": 2147483647,
+                "minimum": -2147483648
             },
             "oneOf": [
                 {
@@ -136,6 +146,7 @@ const ArrayOfPrimitivesDouble = `{
         },
         "luckyBigNumbers": {
             "items": {
+                "pattern": "^-?[0-9]+$",
                 "oneOf": [
                     {
                         "type": "integer"
@@ -146,7 +157,9 @@ const ArrayOfPrimitivesDouble = `{
                     {
                         "type": "null"
                     }
-                ]
+                ],
+                "maximum": 9223372036854775807,
+                "minimum": -9223372036854775808
             },
             "oneOf": [
                 {
@@ -178,6 +191,7 @@ const ArrayOfPrimitivesDouble = `{
             ]
         },
         "big_number": {
+            "pattern": "^-?[0-9]+$",
             "oneOf": [
                 {
                     "type": "integer"
@@ -188,9 +202,12 @@ const ArrayOfPrimitivesDouble = `{
                 {
                     "type": "null"
                 }
-            ]
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
         },
         "bigNumber": {
+            "pattern": "^-?[0-9]+$",
             "oneOf": [
                 {
                     "type": "integer"
@@ -201,7 +218,9 @@ const ArrayOfPrimitivesDouble = `{
                 {
                     "type": "null"
                 }
-            ]
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
         }
     },
     "additionalProperties": true,
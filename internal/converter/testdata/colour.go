@@ -0,0 +1,23 @@
+package testdata
+
+const Colour = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "RED",
+        0,
+        "GREEN",
+        1,
+        "BLUE",
+        2
+    ],
+    "oneOf": [
+        {
+            "type": "string"
+        },
+        {
+            "type": "integer"
+        }
+    ],
+    "title": "Colour",
+    "description": "A primary colour"
+}`
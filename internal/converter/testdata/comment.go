@@ -0,0 +1,52 @@
+package testdata
+
+const Comment = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "text": {
+            "type": "string"
+        },
+        "thread": {
+            "properties": {
+                "comments": {
+                    "items": {
+                        "$ref": "#/definitions/samples.Comment"
+                    },
+                    "type": "array"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.Comment": {
+            "properties": {
+                "text": {
+                    "type": "string"
+                },
+                "thread": {
+                    "$ref": "#/definitions/samples.Thread"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "description": "Messages which refer to each other:"
+        },
+        "samples.Thread": {
+            "properties": {
+                "comments": {
+                    "items": {
+                        "$ref": "#/definitions/samples.Comment"
+                    },
+                    "type": "array"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "description": "Messages which refer to each other:"
+}`
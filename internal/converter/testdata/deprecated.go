@@ -0,0 +1,268 @@
+package testdata
+
+const Genre = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "FICTION",
+        0,
+        "NON_FICTION",
+        1
+    ],
+    "oneOf": [
+        {
+            "enum": [
+                "FICTION",
+                0
+            ],
+            "title": "FICTION"
+        },
+        {
+            "enum": [
+                "NON_FICTION",
+                1
+            ],
+            "title": "NON_FICTION"
+        }
+    ],
+    "description": "Kinds of books:",
+    "deprecated": true,
+    "x-enum-descriptions": [
+        "",
+        "",
+        "",
+        ""
+    ],
+    "x-enumNames": [
+        "FICTION",
+        "FICTION",
+        "NON_FICTION",
+        "NON_FICTION"
+    ]
+}`
+
+const Cupboard = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "Superseded by Shelf:",
+    "deprecated": true
+}`
+
+const Library = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "street": {
+            "type": "string",
+            "description": "Use address instead:",
+            "deprecated": true
+        },
+        "address": {
+            "type": "string"
+        },
+        "status": {
+            "enum": [
+                "OPEN",
+                0,
+                "CLOSED",
+                1,
+                "DEMOLISHED",
+                2
+            ],
+            "oneOf": [
+                {
+                    "enum": [
+                        "OPEN",
+                        0
+                    ],
+                    "title": "OPEN"
+                },
+                {
+                    "enum": [
+                        "CLOSED",
+                        1
+                    ],
+                    "title": "CLOSED"
+                },
+                {
+                    "enum": [
+                        "DEMOLISHED",
+                        2
+                    ],
+                    "title": "DEMOLISHED",
+                    "description": "Closed for good:",
+                    "deprecated": true
+                }
+            ],
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "",
+                "Closed for good:",
+                "Closed for good:"
+            ],
+            "x-enumNames": [
+                "OPEN",
+                "OPEN",
+                "CLOSED",
+                "CLOSED",
+                "DEMOLISHED",
+                "DEMOLISHED"
+            ]
+        },
+        "cupboard": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "deprecated": true
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const LibraryStatus = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "OPEN",
+        0,
+        "CLOSED",
+        1,
+        "DEMOLISHED",
+        2
+    ],
+    "oneOf": [
+        {
+            "enum": [
+                "OPEN",
+                0
+            ],
+            "title": "OPEN"
+        },
+        {
+            "enum": [
+                "CLOSED",
+                1
+            ],
+            "title": "CLOSED"
+        },
+        {
+            "enum": [
+                "DEMOLISHED",
+                2
+            ],
+            "title": "DEMOLISHED",
+            "description": "Closed for good:",
+            "deprecated": true
+        }
+    ],
+    "x-enum-descriptions": [
+        "",
+        "",
+        "",
+        "",
+        "Closed for good:",
+        "Closed for good:"
+    ],
+    "x-enumNames": [
+        "OPEN",
+        "OPEN",
+        "CLOSED",
+        "CLOSED",
+        "DEMOLISHED",
+        "DEMOLISHED"
+    ]
+}`
+
+const LibraryExcludeDeprecated = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "address": {
+            "type": "string"
+        },
+        "status": {
+            "enum": [
+                "OPEN",
+                0,
+                "CLOSED",
+                1
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const LibraryDeprecatedValues = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "street": {
+            "type": "string",
+            "description": "Use address instead:",
+            "deprecated": true
+        },
+        "address": {
+            "type": "string"
+        },
+        "status": {
+            "enum": [
+                "OPEN",
+                0,
+                "CLOSED",
+                1,
+                "DEMOLISHED",
+                2
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ],
+            "x-deprecated": [
+                "DEMOLISHED",
+                2
+            ]
+        },
+        "cupboard": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "deprecated": true
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
@@ -10,7 +10,9 @@ const EnumCeption = `{
             "type": "string"
         },
         "id": {
-            "type": "integer"
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
         },
         "rating": {
             "type": "number"
@@ -43,7 +45,9 @@ const EnumCeption = `{
                     "type": "string"
                 },
                 "id": {
-                    "type": "integer"
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
                 },
                 "rating": {
                     "type": "number"
@@ -90,7 +94,9 @@ const EnumCeption = `{
                         "type": "string"
                     },
                     "id": {
-                        "type": "integer"
+                        "type": "integer",
+                        "maximum": 2147483647,
+                        "minimum": -2147483648
                     },
                     "rating": {
                         "type": "number"
@@ -129,6 +135,40 @@ const EnumCeption = `{
             "type": "array"
         },
         "importedEnum": {
+            "enum": [
+                "VALUE_0",
+                0,
+                "VALUE_1",
+                1,
+                "VALUE_2",
+                2,
+                "VALUE_3",
+                3
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        },
+        "payloadTopology": {
+            "enum": [
+                "FLAT",
+                0,
+                "NESTED_OBJECT",
+                1,
+                "NESTED_MESSAGE",
+                2,
+                "ARRAY_OF_TYPE",
+                3,
+                "ARRAY_OF_OBJECT",
+                4,
+                "ARRAY_OF_MESSAGE",
+                5
+            ],
             "oneOf": [
                 {
                     "type": "string"
@@ -142,3 +182,146 @@ const EnumCeption = `{
     "additionalProperties": true,
     "type": "object"
 }`
+
+const EnumCeptionRefs = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "timestamp": {
+            "type": "string"
+        },
+        "id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "rating": {
+            "type": "number"
+        },
+        "complete": {
+            "type": "boolean"
+        },
+        "failureMode": {
+            "enum": [
+                "RECURSION_ERROR",
+                0,
+                "SYNTAX_ERROR",
+                1
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        },
+        "payload": {
+            "$ref": "#/definitions/samples.PayloadMessage"
+        },
+        "payloads": {
+            "items": {
+                "$ref": "#/definitions/samples.PayloadMessage"
+            },
+            "type": "array"
+        },
+        "importedEnum": {
+            "enum": [
+                "VALUE_0",
+                0,
+                "VALUE_1",
+                1,
+                "VALUE_2",
+                2,
+                "VALUE_3",
+                3
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        },
+        "payloadTopology": {
+            "enum": [
+                "FLAT",
+                0,
+                "NESTED_OBJECT",
+                1,
+                "NESTED_MESSAGE",
+                2,
+                "ARRAY_OF_TYPE",
+                3,
+                "ARRAY_OF_OBJECT",
+                4,
+                "ARRAY_OF_MESSAGE",
+                5
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.PayloadMessage": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    }
+}`
@@ -0,0 +1,384 @@
+package testdata
+
+const EnumEncodingsNames = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "state": {
+            "enum": [
+                "ACCOUNT_STATE_UNKNOWN",
+                "ACCOUNT_STATE_ACTIVE",
+                "ACCOUNT_STATE_SUSPENDED"
+            ],
+            "type": "string"
+        },
+        "history": {
+            "items": {
+                "enum": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED"
+                ],
+                "type": "string"
+            },
+            "type": "array"
+        },
+        "states_by_region": {
+            "additionalProperties": {
+                "enum": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED"
+                ],
+                "type": "string"
+            },
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const EnumEncodingsNumbers = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "state": {
+            "enum": [
+                0,
+                1,
+                2
+            ],
+            "type": "integer"
+        },
+        "history": {
+            "items": {
+                "enum": [
+                    0,
+                    1,
+                    2
+                ],
+                "type": "integer"
+            },
+            "type": "array"
+        },
+        "states_by_region": {
+            "additionalProperties": {
+                "enum": [
+                    0,
+                    1,
+                    2
+                ],
+                "type": "integer"
+            },
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const EnumEncodingsOneOf = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "state": {
+            "enum": [
+                "ACCOUNT_STATE_UNKNOWN",
+                0,
+                "ACCOUNT_STATE_ACTIVE",
+                1,
+                "ACCOUNT_STATE_SUSPENDED",
+                2
+            ],
+            "oneOf": [
+                {
+                    "enum": [
+                        "ACCOUNT_STATE_UNKNOWN",
+                        0
+                    ],
+                    "title": "ACCOUNT_STATE_UNKNOWN"
+                },
+                {
+                    "enum": [
+                        "ACCOUNT_STATE_ACTIVE",
+                        1
+                    ],
+                    "title": "ACCOUNT_STATE_ACTIVE",
+                    "description": "The account can be used"
+                },
+                {
+                    "enum": [
+                        "ACCOUNT_STATE_SUSPENDED",
+                        2
+                    ],
+                    "title": "ACCOUNT_STATE_SUSPENDED",
+                    "description": "The account has been suspended by an administrator"
+                }
+            ],
+            "x-enum-descriptions": [
+                "",
+                "",
+                "The account can be used",
+                "The account can be used",
+                "The account has been suspended by an administrator",
+                "The account has been suspended by an administrator"
+            ],
+            "x-enumNames": [
+                "ACCOUNT_STATE_UNKNOWN",
+                "ACCOUNT_STATE_UNKNOWN",
+                "ACCOUNT_STATE_ACTIVE",
+                "ACCOUNT_STATE_ACTIVE",
+                "ACCOUNT_STATE_SUSPENDED",
+                "ACCOUNT_STATE_SUSPENDED"
+            ]
+        },
+        "history": {
+            "items": {
+                "enum": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    0,
+                    "ACCOUNT_STATE_ACTIVE",
+                    1,
+                    "ACCOUNT_STATE_SUSPENDED",
+                    2
+                ],
+                "oneOf": [
+                    {
+                        "enum": [
+                            "ACCOUNT_STATE_UNKNOWN",
+                            0
+                        ],
+                        "title": "ACCOUNT_STATE_UNKNOWN"
+                    },
+                    {
+                        "enum": [
+                            "ACCOUNT_STATE_ACTIVE",
+                            1
+                        ],
+                        "title": "ACCOUNT_STATE_ACTIVE",
+                        "description": "The account can be used"
+                    },
+                    {
+                        "enum": [
+                            "ACCOUNT_STATE_SUSPENDED",
+                            2
+                        ],
+                        "title": "ACCOUNT_STATE_SUSPENDED",
+                        "description": "The account has been suspended by an administrator"
+                    }
+                ],
+                "x-enum-descriptions": [
+                    "",
+                    "",
+                    "The account can be used",
+                    "The account can be used",
+                    "The account has been suspended by an administrator",
+                    "The account has been suspended by an administrator"
+                ],
+                "x-enumNames": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED",
+                    "ACCOUNT_STATE_SUSPENDED"
+                ]
+            },
+            "type": "array"
+        },
+        "states_by_region": {
+            "additionalProperties": {
+                "enum": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    0,
+                    "ACCOUNT_STATE_ACTIVE",
+                    1,
+                    "ACCOUNT_STATE_SUSPENDED",
+                    2
+                ],
+                "oneOf": [
+                    {
+                        "enum": [
+                            "ACCOUNT_STATE_UNKNOWN",
+                            0
+                        ],
+                        "title": "ACCOUNT_STATE_UNKNOWN"
+                    },
+                    {
+                        "enum": [
+                            "ACCOUNT_STATE_ACTIVE",
+                            1
+                        ],
+                        "title": "ACCOUNT_STATE_ACTIVE",
+                        "description": "The account can be used"
+                    },
+                    {
+                        "enum": [
+                            "ACCOUNT_STATE_SUSPENDED",
+                            2
+                        ],
+                        "title": "ACCOUNT_STATE_SUSPENDED",
+                        "description": "The account has been suspended by an administrator"
+                    }
+                ],
+                "x-enum-descriptions": [
+                    "",
+                    "",
+                    "The account can be used",
+                    "The account can be used",
+                    "The account has been suspended by an administrator",
+                    "The account has been suspended by an administrator"
+                ],
+                "x-enumNames": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED",
+                    "ACCOUNT_STATE_SUSPENDED"
+                ]
+            },
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const EnumEncodingsOneOfNamesDraft07 = `{
+    "$schema": "http://json-schema.org/draft-07/schema#",
+    "properties": {
+        "state": {
+            "enum": [
+                "ACCOUNT_STATE_UNKNOWN",
+                "ACCOUNT_STATE_ACTIVE",
+                "ACCOUNT_STATE_SUSPENDED",
+                null
+            ],
+            "oneOf": [
+                {
+                    "title": "ACCOUNT_STATE_UNKNOWN",
+                    "const": "ACCOUNT_STATE_UNKNOWN"
+                },
+                {
+                    "title": "ACCOUNT_STATE_ACTIVE",
+                    "description": "The account can be used",
+                    "const": "ACCOUNT_STATE_ACTIVE"
+                },
+                {
+                    "title": "ACCOUNT_STATE_SUSPENDED",
+                    "description": "The account has been suspended by an administrator",
+                    "const": "ACCOUNT_STATE_SUSPENDED"
+                },
+                {
+                    "type": "null"
+                }
+            ],
+            "x-enum-descriptions": [
+                "",
+                "The account can be used",
+                "The account has been suspended by an administrator",
+                ""
+            ],
+            "x-enumNames": [
+                "ACCOUNT_STATE_UNKNOWN",
+                "ACCOUNT_STATE_ACTIVE",
+                "ACCOUNT_STATE_SUSPENDED",
+                "null"
+            ]
+        },
+        "history": {
+            "items": {
+                "enum": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED",
+                    null
+                ],
+                "oneOf": [
+                    {
+                        "title": "ACCOUNT_STATE_UNKNOWN",
+                        "const": "ACCOUNT_STATE_UNKNOWN"
+                    },
+                    {
+                        "title": "ACCOUNT_STATE_ACTIVE",
+                        "description": "The account can be used",
+                        "const": "ACCOUNT_STATE_ACTIVE"
+                    },
+                    {
+                        "title": "ACCOUNT_STATE_SUSPENDED",
+                        "description": "The account has been suspended by an administrator",
+                        "const": "ACCOUNT_STATE_SUSPENDED"
+                    },
+                    {
+                        "type": "null"
+                    }
+                ],
+                "x-enum-descriptions": [
+                    "",
+                    "The account can be used",
+                    "The account has been suspended by an administrator",
+                    ""
+                ],
+                "x-enumNames": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED",
+                    "null"
+                ]
+            },
+            "type": [
+                "null",
+                "array"
+            ]
+        },
+        "states_by_region": {
+            "additionalProperties": {
+                "enum": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED",
+                    null
+                ],
+                "oneOf": [
+                    {
+                        "title": "ACCOUNT_STATE_UNKNOWN",
+                        "const": "ACCOUNT_STATE_UNKNOWN"
+                    },
+                    {
+                        "title": "ACCOUNT_STATE_ACTIVE",
+                        "description": "The account can be used",
+                        "const": "ACCOUNT_STATE_ACTIVE"
+                    },
+                    {
+                        "title": "ACCOUNT_STATE_SUSPENDED",
+                        "description": "The account has been suspended by an administrator",
+                        "const": "ACCOUNT_STATE_SUSPENDED"
+                    },
+                    {
+                        "type": "null"
+                    }
+                ],
+                "x-enum-descriptions": [
+                    "",
+                    "The account can be used",
+                    "The account has been suspended by an administrator",
+                    ""
+                ],
+                "x-enumNames": [
+                    "ACCOUNT_STATE_UNKNOWN",
+                    "ACCOUNT_STATE_ACTIVE",
+                    "ACCOUNT_STATE_SUSPENDED",
+                    "null"
+                ]
+            },
+            "type": [
+                "null",
+                "object"
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": [
+        "null",
+        "object"
+    ]
+}`
@@ -0,0 +1,380 @@
+package testdata
+
+const Envelope = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "id": {
+            "type": "string"
+        },
+        "payload": {
+            "required": [
+                "@type"
+            ],
+            "properties": {
+                "@type": {
+                    "type": "string",
+                    "description": "A URL identifying the type of the embedded message (eg \"type.googleapis.com/package.MessageName\")"
+                }
+            },
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        },
+        "attachments": {
+            "items": {
+                "required": [
+                    "@type"
+                ],
+                "properties": {
+                    "@type": {
+                        "type": "string",
+                        "description": "A URL identifying the type of the embedded message (eg \"type.googleapis.com/package.MessageName\")"
+                    }
+                },
+                "additionalProperties": true,
+                "oneOf": [
+                    {
+                        "type": "null"
+                    },
+                    {
+                        "type": "object"
+                    }
+                ]
+            },
+            "type": "array"
+        },
+        "forwarded": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "original": {
+                    "properties": {
+                        "name": {
+                            "type": "string"
+                        },
+                        "timestamp": {
+                            "type": "string"
+                        },
+                        "id": {
+                            "type": "integer",
+                            "maximum": 2147483647,
+                            "minimum": -2147483648
+                        },
+                        "rating": {
+                            "type": "number"
+                        },
+                        "complete": {
+                            "type": "boolean"
+                        },
+                        "topology": {
+                            "enum": [
+                                "FLAT",
+                                0,
+                                "NESTED_OBJECT",
+                                1,
+                                "NESTED_MESSAGE",
+                                2,
+                                "ARRAY_OF_TYPE",
+                                3,
+                                "ARRAY_OF_OBJECT",
+                                4,
+                                "ARRAY_OF_MESSAGE",
+                                5
+                            ],
+                            "oneOf": [
+                                {
+                                    "type": "string"
+                                },
+                                {
+                                    "type": "integer"
+                                }
+                            ]
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "description": "A payload from another package (which Anys are resolved without):"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "An event, which can carry any kind of payload:"
+}`
+
+const EnvelopeResolveAnyTypes = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "id": {
+            "type": "string"
+        },
+        "payload": {
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "$ref": "#/definitions/google.protobuf.Any"
+                }
+            ]
+        },
+        "attachments": {
+            "items": {
+                "oneOf": [
+                    {
+                        "type": "null"
+                    },
+                    {
+                        "$ref": "#/definitions/google.protobuf.Any"
+                    }
+                ]
+            },
+            "type": "array"
+        },
+        "forwarded": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "original": {
+                    "properties": {
+                        "name": {
+                            "type": "string"
+                        },
+                        "timestamp": {
+                            "type": "string"
+                        },
+                        "id": {
+                            "type": "integer",
+                            "maximum": 2147483647,
+                            "minimum": -2147483648
+                        },
+                        "rating": {
+                            "type": "number"
+                        },
+                        "complete": {
+                            "type": "boolean"
+                        },
+                        "topology": {
+                            "enum": [
+                                "FLAT",
+                                0,
+                                "NESTED_OBJECT",
+                                1,
+                                "NESTED_MESSAGE",
+                                2,
+                                "ARRAY_OF_TYPE",
+                                3,
+                                "ARRAY_OF_OBJECT",
+                                4,
+                                "ARRAY_OF_MESSAGE",
+                                5
+                            ],
+                            "oneOf": [
+                                {
+                                    "type": "string"
+                                },
+                                {
+                                    "type": "integer"
+                                }
+                            ]
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "description": "A payload from another package (which Anys are resolved without):"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "google.protobuf.Any": {
+            "required": [
+                "@type"
+            ],
+            "properties": {
+                "@type": {
+                    "type": "string",
+                    "description": "A URL identifying the type of the embedded message (eg \"type.googleapis.com/package.MessageName\")"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "oneOf": [
+                {
+                    "required": [
+                        "@type"
+                    ],
+                    "properties": {
+                        "@type": {
+                            "pattern": "/samples\\.PayloadMessage$"
+                        },
+                        "name": {
+                            "type": "string"
+                        },
+                        "timestamp": {
+                            "type": "string"
+                        },
+                        "id": {
+                            "type": "integer",
+                            "maximum": 2147483647,
+                            "minimum": -2147483648
+                        },
+                        "rating": {
+                            "type": "number"
+                        },
+                        "complete": {
+                            "type": "boolean"
+                        },
+                        "topology": {
+                            "enum": [
+                                "FLAT",
+                                0,
+                                "NESTED_OBJECT",
+                                1,
+                                "NESTED_MESSAGE",
+                                2,
+                                "ARRAY_OF_TYPE",
+                                3,
+                                "ARRAY_OF_OBJECT",
+                                4,
+                                "ARRAY_OF_MESSAGE",
+                                5
+                            ],
+                            "oneOf": [
+                                {
+                                    "type": "string"
+                                },
+                                {
+                                    "type": "integer"
+                                }
+                            ]
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object"
+                },
+                {
+                    "required": [
+                        "@type"
+                    ],
+                    "properties": {
+                        "@type": {
+                            "pattern": "/samples\\.Envelope$"
+                        },
+                        "id": {
+                            "type": "string"
+                        },
+                        "payload": {
+                            "oneOf": [
+                                {
+                                    "type": "null"
+                                },
+                                {
+                                    "$ref": "#/definitions/google.protobuf.Any"
+                                }
+                            ]
+                        },
+                        "attachments": {
+                            "items": {
+                                "oneOf": [
+                                    {
+                                        "type": "null"
+                                    },
+                                    {
+                                        "$ref": "#/definitions/google.protobuf.Any"
+                                    }
+                                ]
+                            },
+                            "type": "array"
+                        },
+                        "forwarded": {
+                            "properties": {
+                                "name": {
+                                    "type": "string"
+                                },
+                                "original": {
+                                    "properties": {
+                                        "name": {
+                                            "type": "string"
+                                        },
+                                        "timestamp": {
+                                            "type": "string"
+                                        },
+                                        "id": {
+                                            "type": "integer",
+                                            "maximum": 2147483647,
+                                            "minimum": -2147483648
+                                        },
+                                        "rating": {
+                                            "type": "number"
+                                        },
+                                        "complete": {
+                                            "type": "boolean"
+                                        },
+                                        "topology": {
+                                            "enum": [
+                                                "FLAT",
+                                                0,
+                                                "NESTED_OBJECT",
+                                                1,
+                                                "NESTED_MESSAGE",
+                                                2,
+                                                "ARRAY_OF_TYPE",
+                                                3,
+                                                "ARRAY_OF_OBJECT",
+                                                4,
+                                                "ARRAY_OF_MESSAGE",
+                                                5
+                                            ],
+                                            "oneOf": [
+                                                {
+                                                    "type": "string"
+                                                },
+                                                {
+                                                    "type": "integer"
+                                                }
+                                            ]
+                                        }
+                                    },
+                                    "additionalProperties": true,
+                                    "type": "object"
+                                }
+                            },
+                            "additionalProperties": true,
+                            "type": "object",
+                            "description": "A payload from another package (which Anys are resolved without):"
+                        }
+                    },
+                    "additionalProperties": true,
+                    "type": "object",
+                    "description": "An event, which can carry any kind of payload:"
+                },
+                {
+                    "not": {
+                        "properties": {
+                            "@type": {
+                                "pattern": "(/samples\\.PayloadMessage$)|(/samples\\.Envelope$)"
+                            }
+                        }
+                    }
+                }
+            ]
+        }
+    },
+    "description": "An event, which can carry any kind of payload:"
+}`
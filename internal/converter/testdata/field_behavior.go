@@ -0,0 +1,123 @@
+package testdata
+
+const Shelf = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "theme"
+    ],
+    "properties": {
+        "name": {
+            "type": "string",
+            "description": "Assigned by the server:",
+            "readOnly": true
+        },
+        "theme": {
+            "type": "string"
+        },
+        "request_id": {
+            "type": "string",
+            "description": "Makes retried requests idempotent:",
+            "writeOnly": true
+        },
+        "location": {
+            "type": "string"
+        },
+        "label": {
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "usage": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648,
+                    "readOnly": true
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "A shelf of books:"
+}`
+
+const ShelfInput = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "theme"
+    ],
+    "properties": {
+        "theme": {
+            "type": "string"
+        },
+        "request_id": {
+            "type": "string",
+            "description": "Makes retried requests idempotent:",
+            "writeOnly": true
+        },
+        "location": {
+            "type": "string"
+        },
+        "label": {
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "A shelf of books:"
+}`
+
+const ShelfOutput = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "theme"
+    ],
+    "properties": {
+        "name": {
+            "type": "string",
+            "description": "Assigned by the server:",
+            "readOnly": true
+        },
+        "theme": {
+            "type": "string"
+        },
+        "location": {
+            "type": "string"
+        },
+        "label": {
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "usage": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648,
+                    "readOnly": true
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "A shelf of books:"
+}`
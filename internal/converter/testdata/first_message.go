@@ -10,7 +10,9 @@ const FirstMessage = `{
             "type": "string"
         },
         "id1": {
-            "type": "integer"
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
         },
         "rating1": {
             "type": "number"
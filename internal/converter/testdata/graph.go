@@ -0,0 +1,37 @@
+package testdata
+
+const Graph = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "label": {
+            "type": "string"
+        },
+        "subgraphs": {
+            "additionalProperties": {
+                "$ref": "#/definitions/samples.Graph"
+            },
+            "type": "object"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.Graph": {
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "subgraphs": {
+                    "additionalProperties": {
+                        "$ref": "#/definitions/samples.Graph"
+                    },
+                    "type": "object"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "description": "A message which refers to itself through the values of a map:"
+        }
+    },
+    "description": "A message which refers to itself through the values of a map:"
+}`
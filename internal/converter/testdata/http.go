@@ -0,0 +1,382 @@
+package testdata
+
+const Book = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const GetBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "include_drafts": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const CreateBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "parent": {
+            "type": "string"
+        },
+        "book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "book_id": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const UpdateBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "allow_missing": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const MoveBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "destination_shelf": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const BookServiceGetBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "include_drafts": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.GetBook request",
+    "description": "Gets a book:"
+}`
+
+const BookServiceGetBookResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.GetBook response",
+    "description": "Gets a book:"
+}`
+
+const BookServiceGetBookQuery = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "include_drafts": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.GetBook query (GET /v1/{name=shelves/*/books/*})",
+    "description": "Gets a book:",
+    "x-http-method": "GET",
+    "x-http-path": "/v1/{name=shelves/*/books/*}"
+}`
+
+const BookServiceCreateBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "parent": {
+            "type": "string"
+        },
+        "book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "book_id": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.CreateBook request",
+    "description": "Adds a book to a shelf:"
+}`
+
+const BookServiceCreateBookResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.CreateBook response",
+    "description": "Adds a book to a shelf:"
+}`
+
+const BookServiceCreateBookBody = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.CreateBook body (POST /v1/{parent=shelves/*}/books)",
+    "description": "Adds a book to a shelf:",
+    "x-http-method": "POST",
+    "x-http-path": "/v1/{parent=shelves/*}/books"
+}`
+
+const BookServiceCreateBookQuery = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "book_id": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.CreateBook query (POST /v1/{parent=shelves/*}/books)",
+    "description": "Adds a book to a shelf:",
+    "x-http-method": "POST",
+    "x-http-path": "/v1/{parent=shelves/*}/books"
+}`
+
+const BookServiceUpdateBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "allow_missing": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.UpdateBook request",
+    "description": "Changes a book:"
+}`
+
+const BookServiceUpdateBookResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.UpdateBook response",
+    "description": "Changes a book:"
+}`
+
+const BookServiceUpdateBookBody = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.UpdateBook body (PATCH /v1/{book.name=shelves/*/books/*})",
+    "description": "Changes a book:",
+    "x-http-method": "PATCH",
+    "x-http-path": "/v1/{book.name=shelves/*/books/*}"
+}`
+
+const BookServiceUpdateBookQuery = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "allow_missing": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.UpdateBook query (PATCH /v1/{book.name=shelves/*/books/*})",
+    "description": "Changes a book:",
+    "x-http-method": "PATCH",
+    "x-http-path": "/v1/{book.name=shelves/*/books/*}"
+}`
+
+const BookServiceMoveBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "destination_shelf": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.MoveBook request",
+    "description": "Moves a book to another shelf:"
+}`
+
+const BookServiceMoveBookResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.MoveBook response",
+    "description": "Moves a book to another shelf:"
+}`
+
+const BookServiceMoveBookBody = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "destination_shelf": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.MoveBook body (POST /v1/{name=shelves/*/books/*}:move)",
+    "description": "Moves a book to another shelf:",
+    "x-http-method": "POST",
+    "x-http-path": "/v1/{name=shelves/*/books/*}:move"
+}`
+
+const BookServiceMoveBook1Body = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "destination_shelf": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "BookService.MoveBook.1 body (MOVE /v1/books/{name})",
+    "description": "Moves a book to another shelf:",
+    "x-http-method": "MOVE",
+    "x-http-path": "/v1/books/{name}"
+}`
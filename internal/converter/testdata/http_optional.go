@@ -0,0 +1,172 @@
+package testdata
+
+const RenameBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "book": {
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "$ref": "#/definitions/samples.Book"
+                }
+            ]
+        },
+        "title": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.Book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    }
+}`
+
+const ShelfServiceRenameBookRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "book": {
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "$ref": "#/definitions/samples.Book"
+                }
+            ]
+        },
+        "title": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.Book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "title": "ShelfService.RenameBook request",
+    "description": "Renames a book (the path names it, even though the book is optional):"
+}`
+
+const ShelfServiceRenameBookResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "title": {
+            "type": "string"
+        },
+        "author": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "ShelfService.RenameBook response",
+    "description": "Renames a book (the path names it, even though the book is optional):"
+}`
+
+const ShelfServiceRenameBookBody = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "oneOf": [
+        {
+            "type": "null"
+        },
+        {
+            "properties": {
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    ],
+    "definitions": {
+        "samples.Book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "title": "ShelfService.RenameBook body (PATCH /v1/{book.name=shelves/*/books/*})",
+    "description": "Renames a book (the path names it, even though the book is optional):",
+    "x-http-method": "PATCH",
+    "x-http-path": "/v1/{book.name=shelves/*/books/*}"
+}`
+
+const ShelfServiceRenameBookQuery = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "title": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.Book": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    },
+    "title": "ShelfService.RenameBook query (PATCH /v1/{book.name=shelves/*/books/*})",
+    "description": "Renames a book (the path names it, even though the book is optional):",
+    "x-http-method": "PATCH",
+    "x-http-path": "/v1/{book.name=shelves/*/books/*}"
+}`
@@ -21,3 +21,21 @@ const ImportedEnum = `{
         }
     ]
 }`
+
+const ImportedEnumDraft201909 = `{
+    "$schema": "https://json-schema.org/draft/2019-09/schema",
+    "enum": [
+        "VALUE_0",
+        0,
+        "VALUE_1",
+        1,
+        "VALUE_2",
+        2,
+        "VALUE_3",
+        3
+    ],
+    "type": [
+        "string",
+        "integer"
+    ]
+}`
@@ -11,7 +11,9 @@ const Maps = `{
         },
         "map_of_ints": {
             "additionalProperties": {
-                "type": "integer"
+                "type": "integer",
+                "maximum": 2147483647,
+                "minimum": -2147483648
             },
             "type": "object"
         },
@@ -25,7 +27,9 @@ const Maps = `{
                         "type": "string"
                     },
                     "id": {
-                        "type": "integer"
+                        "type": "integer",
+                        "maximum": 2147483647,
+                        "minimum": -2147483648
                     },
                     "rating": {
                         "type": "number"
@@ -62,8 +66,381 @@ const Maps = `{
                 "type": "object"
             },
             "type": "object"
+        },
+        "map_of_flags": {
+            "patternProperties": {
+                "^(true|false)$": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object"
+        },
+        "map_by_id": {
+            "patternProperties": {
+                "^(0|[1-9][0-9]{0,18}|1[0-7][0-9]{18}|18[0-3][0-9]{17}|184[0-3][0-9]{16}|1844[0-5][0-9]{15}|18446[0-6][0-9]{14}|184467[0-3][0-9]{13}|1844674[0-3][0-9]{12}|184467440[0-6][0-9]{10}|1844674407[0-2][0-9]{9}|18446744073[0-6][0-9]{8}|1844674407370[0-8][0-9]{6}|18446744073709[0-4][0-9]{5}|184467440737095[0-4][0-9]{4}|18446744073709550[0-9]{3}|18446744073709551[0-5][0-9]{2}|1844674407370955160[0-9]|1844674407370955161[0-4]|18446744073709551615)$": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object"
         }
     },
     "additionalProperties": true,
     "type": "object"
 }`
+
+const MapsRefs = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "map_of_strings": {
+            "additionalProperties": {
+                "oneOf": [
+                    {
+                        "type": "null"
+                    },
+                    {
+                        "type": "string"
+                    }
+                ]
+            },
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        },
+        "map_of_ints": {
+            "additionalProperties": {
+                "oneOf": [
+                    {
+                        "type": "null"
+                    },
+                    {
+                        "type": "integer"
+                    }
+                ],
+                "maximum": 2147483647,
+                "minimum": -2147483648
+            },
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        },
+        "map_of_messages": {
+            "additionalProperties": {
+                "$ref": "#/definitions/samples.PayloadMessage"
+            },
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        },
+        "map_of_flags": {
+            "patternProperties": {
+                "^(true|false)$": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": false,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        },
+        "map_by_id": {
+            "patternProperties": {
+                "^(0|[1-9][0-9]{0,18}|1[0-7][0-9]{18}|18[0-3][0-9]{17}|184[0-3][0-9]{16}|1844[0-5][0-9]{15}|18446[0-6][0-9]{14}|184467[0-3][0-9]{13}|1844674[0-3][0-9]{12}|184467440[0-6][0-9]{10}|1844674407[0-2][0-9]{9}|18446744073[0-6][0-9]{8}|1844674407370[0-8][0-9]{6}|18446744073709[0-4][0-9]{5}|184467440737095[0-4][0-9]{4}|18446744073709550[0-9]{3}|18446744073709551[0-5][0-9]{2}|1844674407370955160[0-9]|1844674407370955161[0-4]|18446744073709551615)$": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": false,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "oneOf": [
+        {
+            "type": "null"
+        },
+        {
+            "type": "object"
+        }
+    ],
+    "definitions": {
+        "samples.PayloadMessage": {
+            "properties": {
+                "name": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "timestamp": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "string"
+                        }
+                    ]
+                },
+                "id": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ],
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
+                },
+                "rating": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "number"
+                        }
+                    ]
+                },
+                "complete": {
+                    "oneOf": [
+                        {
+                            "type": "null"
+                        },
+                        {
+                            "type": "boolean"
+                        }
+                    ]
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5,
+                        null
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        },
+                        {
+                            "type": "null"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "oneOf": [
+                {
+                    "type": "null"
+                },
+                {
+                    "type": "object"
+                }
+            ]
+        }
+    }
+}`
+
+const MapsRefsDraft202012 = `{
+    "$schema": "https://json-schema.org/draft/2020-12/schema",
+    "properties": {
+        "map_of_strings": {
+            "additionalProperties": {
+                "type": [
+                    "null",
+                    "string"
+                ]
+            },
+            "type": [
+                "null",
+                "object"
+            ]
+        },
+        "map_of_ints": {
+            "additionalProperties": {
+                "maximum": 2147483647,
+                "minimum": -2147483648,
+                "type": [
+                    "null",
+                    "integer"
+                ]
+            },
+            "type": [
+                "null",
+                "object"
+            ]
+        },
+        "map_of_messages": {
+            "additionalProperties": {
+                "$ref": "#/$defs/samples.PayloadMessage"
+            },
+            "type": [
+                "null",
+                "object"
+            ]
+        },
+        "map_of_flags": {
+            "additionalProperties": {
+                "type": [
+                    "null",
+                    "string"
+                ]
+            },
+            "propertyNames": {
+                "enum": [
+                    "true",
+                    "false"
+                ]
+            },
+            "type": [
+                "null",
+                "object"
+            ]
+        },
+        "map_by_id": {
+            "additionalProperties": {
+                "type": [
+                    "null",
+                    "string"
+                ]
+            },
+            "propertyNames": {
+                "pattern": "^(0|[1-9][0-9]{0,18}|1[0-7][0-9]{18}|18[0-3][0-9]{17}|184[0-3][0-9]{16}|1844[0-5][0-9]{15}|18446[0-6][0-9]{14}|184467[0-3][0-9]{13}|1844674[0-3][0-9]{12}|184467440[0-6][0-9]{10}|1844674407[0-2][0-9]{9}|18446744073[0-6][0-9]{8}|1844674407370[0-8][0-9]{6}|18446744073709[0-4][0-9]{5}|184467440737095[0-4][0-9]{4}|18446744073709550[0-9]{3}|18446744073709551[0-5][0-9]{2}|1844674407370955160[0-9]|1844674407370955161[0-4]|18446744073709551615)$"
+            },
+            "type": [
+                "null",
+                "object"
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "$defs": {
+        "samples.PayloadMessage": {
+            "properties": {
+                "name": {
+                    "type": [
+                        "null",
+                        "string"
+                    ]
+                },
+                "timestamp": {
+                    "type": [
+                        "null",
+                        "string"
+                    ]
+                },
+                "id": {
+                    "maximum": 2147483647,
+                    "minimum": -2147483648,
+                    "type": [
+                        "null",
+                        "integer"
+                    ]
+                },
+                "rating": {
+                    "type": [
+                        "null",
+                        "number"
+                    ]
+                },
+                "complete": {
+                    "type": [
+                        "null",
+                        "boolean"
+                    ]
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5,
+                        null
+                    ],
+                    "type": [
+                        "string",
+                        "integer",
+                        "null"
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": [
+                "null",
+                "object"
+            ]
+        }
+    },
+    "type": [
+        "null",
+        "object"
+    ]
+}`
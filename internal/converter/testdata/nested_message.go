@@ -12,7 +12,9 @@ const NestedMessage = `{
                     "type": "string"
                 },
                 "id": {
-                    "type": "integer"
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
                 },
                 "rating": {
                     "type": "number"
@@ -12,7 +12,9 @@ const NestedObject = `{
                     "type": "string"
                 },
                 "id": {
-                    "type": "integer"
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
                 },
                 "rating": {
                     "type": "number"
@@ -55,3 +57,78 @@ const NestedObject = `{
     "additionalProperties": true,
     "type": "object"
 }`
+
+const NestedObjectNestedPayload = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "timestamp": {
+            "type": "string"
+        },
+        "id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "rating": {
+            "type": "number"
+        },
+        "complete": {
+            "type": "boolean"
+        },
+        "topology": {
+            "enum": [
+                "FLAT",
+                0,
+                "NESTED_OBJECT",
+                1,
+                "NESTED_MESSAGE",
+                2,
+                "ARRAY_OF_TYPE",
+                3,
+                "ARRAY_OF_OBJECT",
+                4,
+                "ARRAY_OF_MESSAGE",
+                5
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const NestedObjectNestedPayloadTopology = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "enum": [
+        "FLAT",
+        0,
+        "NESTED_OBJECT",
+        1,
+        "NESTED_MESSAGE",
+        2,
+        "ARRAY_OF_TYPE",
+        3,
+        "ARRAY_OF_OBJECT",
+        4,
+        "ARRAY_OF_MESSAGE",
+        5
+    ],
+    "oneOf": [
+        {
+            "type": "string"
+        },
+        {
+            "type": "integer"
+        }
+    ]
+}`
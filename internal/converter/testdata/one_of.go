@@ -0,0 +1,281 @@
+package testdata
+
+const OneOf = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "description": {
+            "type": "string"
+        },
+        "email_address": {
+            "type": "string"
+        },
+        "phone_number": {
+            "type": "string"
+        },
+        "callback": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "urgency": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "contact": {
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "allOf": [
+                {
+                    "not": {
+                        "anyOf": [
+                            {
+                                "required": [
+                                    "email",
+                                    "phone"
+                                ]
+                            }
+                        ]
+                    }
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "allOf": [
+        {
+            "not": {
+                "anyOf": [
+                    {
+                        "required": [
+                            "email_address",
+                            "phone_number"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "email_address",
+                            "callback"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "phone_number",
+                            "callback"
+                        ]
+                    }
+                ]
+            },
+            "description": "Where the notification should be delivered to:"
+        }
+    ]
+}`
+
+const OneOfDouble = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "description": {
+            "type": "string"
+        },
+        "email_address": {
+            "type": "string"
+        },
+        "emailAddress": {
+            "type": "string"
+        },
+        "phone_number": {
+            "type": "string"
+        },
+        "phoneNumber": {
+            "type": "string"
+        },
+        "callback": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "urgency": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "contact": {
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "allOf": [
+                {
+                    "not": {
+                        "anyOf": [
+                            {
+                                "required": [
+                                    "email",
+                                    "phone"
+                                ]
+                            }
+                        ]
+                    }
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "allOf": [
+        {
+            "not": {
+                "anyOf": [
+                    {
+                        "required": [
+                            "email_address",
+                            "phone_number"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "email_address",
+                            "phoneNumber"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "emailAddress",
+                            "phone_number"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "emailAddress",
+                            "phoneNumber"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "email_address",
+                            "callback"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "emailAddress",
+                            "callback"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "phone_number",
+                            "callback"
+                        ]
+                    },
+                    {
+                        "required": [
+                            "phoneNumber",
+                            "callback"
+                        ]
+                    }
+                ]
+            },
+            "description": "Where the notification should be delivered to:"
+        }
+    ]
+}`
@@ -0,0 +1,198 @@
+package testdata
+
+const EnumCeptionOpenAPI = `openapi: 3.1.0
+info:
+  title: PayloadMessage.proto, ImportedEnum.proto, Enumception.proto
+  version: 0.0.0
+components:
+  schemas:
+    samples.Enumception:
+      properties:
+        name:
+          type:
+            - "null"
+            - string
+        timestamp:
+          type:
+            - "null"
+            - string
+        id:
+          maximum: 2147483647
+          minimum: -2147483648
+          type:
+            - "null"
+            - integer
+        rating:
+          type:
+            - "null"
+            - number
+        complete:
+          type:
+            - "null"
+            - boolean
+        failureMode:
+          oneOf:
+            - type: "null"
+            - $ref: '#/components/schemas/samples.Enumception.FailureModes'
+        payload:
+          $ref: '#/components/schemas/samples.PayloadMessage'
+        payloads:
+          items:
+            $ref: '#/components/schemas/samples.PayloadMessage'
+          type:
+            - "null"
+            - array
+        importedEnum:
+          oneOf:
+            - type: "null"
+            - $ref: '#/components/schemas/samples.ImportedEnum'
+        payloadTopology:
+          oneOf:
+            - type: "null"
+            - $ref: '#/components/schemas/samples.PayloadMessage.Topology'
+      additionalProperties: true
+      type:
+        - "null"
+        - object
+    samples.Enumception.FailureModes:
+      enum:
+        - RECURSION_ERROR
+        - 0
+        - SYNTAX_ERROR
+        - 1
+      type:
+        - string
+        - integer
+    samples.ImportedEnum:
+      enum:
+        - VALUE_0
+        - 0
+        - VALUE_1
+        - 1
+        - VALUE_2
+        - 2
+        - VALUE_3
+        - 3
+      type:
+        - string
+        - integer
+    samples.PayloadMessage:
+      properties:
+        name:
+          type:
+            - "null"
+            - string
+        timestamp:
+          type:
+            - "null"
+            - string
+        id:
+          maximum: 2147483647
+          minimum: -2147483648
+          type:
+            - "null"
+            - integer
+        rating:
+          type:
+            - "null"
+            - number
+        complete:
+          type:
+            - "null"
+            - boolean
+        topology:
+          oneOf:
+            - type: "null"
+            - $ref: '#/components/schemas/samples.PayloadMessage.Topology'
+      additionalProperties: true
+      type:
+        - "null"
+        - object
+    samples.PayloadMessage.Topology:
+      enum:
+        - FLAT
+        - 0
+        - NESTED_OBJECT
+        - 1
+        - NESTED_MESSAGE
+        - 2
+        - ARRAY_OF_TYPE
+        - 3
+        - ARRAY_OF_OBJECT
+        - 4
+        - ARRAY_OF_MESSAGE
+        - 5
+      type:
+        - string
+        - integer
+`
+
+const NestedMessageOpenAPIJSON = `{
+    "openapi": "3.1.0",
+    "info": {
+        "title": "NestedMessage.proto",
+        "version": "0.0.0"
+    },
+    "components": {
+        "schemas": {
+            "samples.NestedMessage": {
+                "properties": {
+                    "payload": {
+                        "$ref": "#/components/schemas/samples.PayloadMessage"
+                    },
+                    "description": {
+                        "type": "string"
+                    }
+                },
+                "additionalProperties": true,
+                "type": "object"
+            },
+            "samples.PayloadMessage": {
+                "properties": {
+                    "name": {
+                        "type": "string"
+                    },
+                    "timestamp": {
+                        "type": "string"
+                    },
+                    "id": {
+                        "type": "integer",
+                        "maximum": 2147483647,
+                        "minimum": -2147483648
+                    },
+                    "rating": {
+                        "type": "number"
+                    },
+                    "complete": {
+                        "type": "boolean"
+                    },
+                    "topology": {
+                        "$ref": "#/components/schemas/samples.PayloadMessage.Topology"
+                    }
+                },
+                "additionalProperties": true,
+                "type": "object"
+            },
+            "samples.PayloadMessage.Topology": {
+                "enum": [
+                    "FLAT",
+                    0,
+                    "NESTED_OBJECT",
+                    1,
+                    "NESTED_MESSAGE",
+                    2,
+                    "ARRAY_OF_TYPE",
+                    3,
+                    "ARRAY_OF_OBJECT",
+                    4,
+                    "ARRAY_OF_MESSAGE",
+                    5
+                ],
+                "type": [
+                    "string",
+                    "integer"
+                ]
+            }
+        }
+    }
+}`
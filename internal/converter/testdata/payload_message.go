@@ -10,7 +10,9 @@ const PayloadMessage = `{
             "type": "string"
         },
         "id": {
-            "type": "integer"
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
         },
         "rating": {
             "type": "number"
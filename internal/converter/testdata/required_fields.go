@@ -0,0 +1,248 @@
+package testdata
+
+const RequiredFields = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "name",
+        "user_id",
+        "payload"
+    ],
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "nick_name": {
+            "type": "string"
+        },
+        "user_id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "aliases": {
+            "items": {
+                "type": "string"
+            },
+            "type": "array"
+        },
+        "payload": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "inner": {
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "inners": {
+            "items": {
+                "$schema": "http://json-schema.org/draft-04/schema#",
+                "required": [
+                    "id"
+                ],
+                "properties": {
+                    "id": {
+                        "type": "string"
+                    },
+                    "label": {
+                        "type": "string"
+                    }
+                },
+                "additionalProperties": true,
+                "type": "object",
+                "description": "Nested messages have required fields of their own (whether they're repeated or not):"
+            },
+            "type": "array"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const RequiredFieldsDouble = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "name",
+        "payload"
+    ],
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "nick_name": {
+            "type": "string"
+        },
+        "nickName": {
+            "type": "string"
+        },
+        "user_id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "userId": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "aliases": {
+            "items": {
+                "type": "string"
+            },
+            "type": "array"
+        },
+        "payload": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "maximum": 2147483647,
+                    "minimum": -2147483648
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "complete": {
+                    "type": "boolean"
+                },
+                "topology": {
+                    "enum": [
+                        "FLAT",
+                        0,
+                        "NESTED_OBJECT",
+                        1,
+                        "NESTED_MESSAGE",
+                        2,
+                        "ARRAY_OF_TYPE",
+                        3,
+                        "ARRAY_OF_OBJECT",
+                        4,
+                        "ARRAY_OF_MESSAGE",
+                        5
+                    ],
+                    "oneOf": [
+                        {
+                            "type": "string"
+                        },
+                        {
+                            "type": "integer"
+                        }
+                    ]
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "inner": {
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "inners": {
+            "items": {
+                "$schema": "http://json-schema.org/draft-04/schema#",
+                "required": [
+                    "id"
+                ],
+                "properties": {
+                    "id": {
+                        "type": "string"
+                    },
+                    "label": {
+                        "type": "string"
+                    }
+                },
+                "additionalProperties": true,
+                "type": "object",
+                "description": "Nested messages have required fields of their own (whether they're repeated or not):"
+            },
+            "type": "array"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "allOf": [
+        {
+            "anyOf": [
+                {
+                    "required": [
+                        "user_id"
+                    ]
+                },
+                {
+                    "required": [
+                        "userId"
+                    ]
+                }
+            ]
+        }
+    ]
+}`
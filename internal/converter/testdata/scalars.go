@@ -0,0 +1,407 @@
+package testdata
+
+const Scalars = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "double_value": {
+            "type": "number"
+        },
+        "float_value": {
+            "type": "number"
+        },
+        "int32_value": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "int64_value": {
+            "pattern": "^-?[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "uint32_value": {
+            "type": "integer",
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "uint64_value": {
+            "pattern": "^[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 18446744073709551615,
+            "minimum": 0
+        },
+        "sint32_value": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "sint64_value": {
+            "pattern": "^-?[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "fixed32_value": {
+            "type": "integer",
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "fixed64_value": {
+            "pattern": "^[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 18446744073709551615,
+            "minimum": 0
+        },
+        "sfixed32_value": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "sfixed64_value": {
+            "pattern": "^-?[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "bool_value": {
+            "type": "boolean"
+        },
+        "string_value": {
+            "type": "string"
+        },
+        "bytes_value": {
+            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+            "type": "string",
+            "contentEncoding": "base64"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "One field of every scalar type:"
+}`
+
+const ScalarsFloat32Range = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "double_value": {
+            "type": "number"
+        },
+        "float_value": {
+            "type": "number",
+            "maximum": 3.4028234663852886e+38,
+            "minimum": -3.4028234663852886e+38
+        },
+        "int32_value": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "int64_value": {
+            "pattern": "^-?[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "uint32_value": {
+            "type": "integer",
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "uint64_value": {
+            "pattern": "^[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 18446744073709551615,
+            "minimum": 0
+        },
+        "sint32_value": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "sint64_value": {
+            "pattern": "^-?[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "fixed32_value": {
+            "type": "integer",
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "fixed64_value": {
+            "pattern": "^[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 18446744073709551615,
+            "minimum": 0
+        },
+        "sfixed32_value": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "sfixed64_value": {
+            "pattern": "^-?[0-9]+$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "bool_value": {
+            "type": "boolean"
+        },
+        "string_value": {
+            "type": "string"
+        },
+        "bytes_value": {
+            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+            "type": "string",
+            "contentEncoding": "base64"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "One field of every scalar type:"
+}`
+
+const ScalarsProtojsonCompat = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "double_value": {
+            "pattern": "^(NaN|-?Infinity|-?(0(\\.0+)?([eE][+-]?[0-9]+)?|(0|[1-9][0-9]{0,307})(\\.[0-9]+)?([eE](-[0-9]+|\\+?0+))?|[1-9](\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-6]|307)|[1-9][0-9]{1}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-5]|306)|[1-9][0-9]{2}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-4]|305)|[1-9][0-9]{3}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-3]|304)|[1-9][0-9]{4}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-2]|303)|[1-9][0-9]{5}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-1]|302)|[1-9][0-9]{6}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|300|301)|[1-9][0-9]{7}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|300)|[1-9][0-9]{8}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-8]|299)|[1-9][0-9]{9}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-7]|298)|[1-9][0-9]{10}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-6]|297)|[1-9][0-9]{11}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-5]|296)|[1-9][0-9]{12}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-4]|295)|[1-9][0-9]{13}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-3]|294)|[1-9][0-9]{14}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-2]|293)|[1-9][0-9]{15}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|29[0-1]|292)|[1-9][0-9]{16}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|290|291)|[1-9][0-9]{17}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-8][0-9]|290)|[1-9][0-9]{18}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-8]|289)|[1-9][0-9]{19}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-7]|288)|[1-9][0-9]{20}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-6]|287)|[1-9][0-9]{21}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-5]|286)|[1-9][0-9]{22}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-4]|285)|[1-9][0-9]{23}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-3]|284)|[1-9][0-9]{24}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-2]|283)|[1-9][0-9]{25}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|28[0-1]|282)|[1-9][0-9]{26}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|280|281)|[1-9][0-9]{27}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-7][0-9]|280)|[1-9][0-9]{28}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-8]|279)|[1-9][0-9]{29}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-7]|278)|[1-9][0-9]{30}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-6]|277)|[1-9][0-9]{31}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-5]|276)|[1-9][0-9]{32}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-4]|275)|[1-9][0-9]{33}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-3]|274)|[1-9][0-9]{34}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-2]|273)|[1-9][0-9]{35}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|27[0-1]|272)|[1-9][0-9]{36}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|270|271)|[1-9][0-9]{37}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-6][0-9]|270)|[1-9][0-9]{38}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-5][0-9]|26[0-8]|269)|[1-9][0-9]{39}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9][0-9]{0,1}|1[0-9]{2}|2[0-5][0-9]|26[0-7]|268)|0\\.0{0}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-7]|308)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*309)|0\\.0{1}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-8]|309)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*310)|0\\.0{2}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|310)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*311)|0\\.0{3}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|310|311)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*312)|0\\.0{4}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-1]|312)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*313)|0\\.0{5}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-2]|313)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*314)|0\\.0{6}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-3]|314)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*315)|0\\.0{7}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-4]|315)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*316)|0\\.0{8}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-5]|316)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*317)|0\\.0{9}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-6]|317)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*318)|0\\.0{10}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-7]|318)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*319)|0\\.0{11}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|30[0-9]|31[0-8]|319)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*320)|0\\.0{12}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|320)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*321)|0\\.0{13}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|320|321)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*322)|0\\.0{14}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-1]|322)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*323)|0\\.0{15}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-2]|323)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*324)|0\\.0{16}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-3]|324)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*325)|0\\.0{17}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-4]|325)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*326)|0\\.0{18}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-5]|326)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*327)|0\\.0{19}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-6]|327)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*328)|0\\.0{20}([0-9]+[eE]\\+?0*(0|[1-9][0-9]{0,1}|[1-2][0-9]{2}|3[0-1][0-9]|32[0-7]|328)|(0[0-9]*|1([0-6][0-9]*|7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*329)|(1(\\.[0-6][0-9]*|\\.7([0-8][0-9]*|9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)[eE]\\+?0*308|(1([0-6](\\.[0-9]+)?|7(\\.[0-8][0-9]*|\\.9([0-6][0-9]*|7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?))[eE]\\+?0*307|(1([0-6][0-9]{1}(\\.[0-9]+)?|7([0-8](\\.[0-9]+)?|9(\\.[0-6][0-9]*|\\.7([0-5][0-9]*|6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)))[eE]\\+?0*306|(1([0-6][0-9]{2}(\\.[0-9]+)?|7([0-8][0-9]{1}(\\.[0-9]+)?|9([0-6](\\.[0-9]+)?|7(\\.[0-5][0-9]*|\\.6([0-8][0-9]*|9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)?)?)?))))[eE]\\+?0*305|(1([0-6][0-9]{3}(\\.[0-9]+)?|7([0-8][0-9]{2}(\\.[0-9]+)?|9([0-6][0-9]{1}(\\.[0-9]+)?|7([0-5](\\.[0-9]+)?|6(\\.[0-8][0-9]*|\\.9([0-2][0-9]*|3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)?)?)))))[eE]\\+?0*304|(1([0-6][0-9]{4}(\\.[0-9]+)?|7([0-8][0-9]{3}(\\.[0-9]+)?|9([0-6][0-9]{2}(\\.[0-9]+)?|7([0-5][0-9]{1}(\\.[0-9]+)?|6([0-8](\\.[0-9]+)?|9(\\.[0-2][0-9]*|\\.3(0[0-9]*|1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)?))))))[eE]\\+?0*303|(1([0-6][0-9]{5}(\\.[0-9]+)?|7([0-8][0-9]{4}(\\.[0-9]+)?|9([0-6][0-9]{3}(\\.[0-9]+)?|7([0-5][0-9]{2}(\\.[0-9]+)?|6([0-8][0-9]{1}(\\.[0-9]+)?|9([0-2](\\.[0-9]+)?|3(\\.0[0-9]*|\\.1([0-2][0-9]*|3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?)?)))))))[eE]\\+?0*302|(1([0-6][0-9]{6}(\\.[0-9]+)?|7([0-8][0-9]{5}(\\.[0-9]+)?|9([0-6][0-9]{4}(\\.[0-9]+)?|7([0-5][0-9]{3}(\\.[0-9]+)?|6([0-8][0-9]{2}(\\.[0-9]+)?|9([0-2][0-9]{1}(\\.[0-9]+)?|3(0(\\.[0-9]+)?|1(\\.[0-2][0-9]*|\\.3([0-3][0-9]*|4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)?))))))))[eE]\\+?0*301|(1([0-6][0-9]{7}(\\.[0-9]+)?|7([0-8][0-9]{6}(\\.[0-9]+)?|9([0-6][0-9]{5}(\\.[0-9]+)?|7([0-5][0-9]{4}(\\.[0-9]+)?|6([0-8][0-9]{3}(\\.[0-9]+)?|9([0-2][0-9]{2}(\\.[0-9]+)?|3(0[0-9]{1}(\\.[0-9]+)?|1([0-2](\\.[0-9]+)?|3(\\.[0-3][0-9]*|\\.4([0-7][0-9]*|8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?)?)))))))))[eE]\\+?0*300|(1([0-6][0-9]{8}(\\.[0-9]+)?|7([0-8][0-9]{7}(\\.[0-9]+)?|9([0-6][0-9]{6}(\\.[0-9]+)?|7([0-5][0-9]{5}(\\.[0-9]+)?|6([0-8][0-9]{4}(\\.[0-9]+)?|9([0-2][0-9]{3}(\\.[0-9]+)?|3(0[0-9]{2}(\\.[0-9]+)?|1([0-2][0-9]{1}(\\.[0-9]+)?|3([0-3](\\.[0-9]+)?|4(\\.[0-7][0-9]*|\\.8([0-5][0-9]*|6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)?))))))))))[eE]\\+?0*299|(1([0-6][0-9]{9}(\\.[0-9]+)?|7([0-8][0-9]{8}(\\.[0-9]+)?|9([0-6][0-9]{7}(\\.[0-9]+)?|7([0-5][0-9]{6}(\\.[0-9]+)?|6([0-8][0-9]{5}(\\.[0-9]+)?|9([0-2][0-9]{4}(\\.[0-9]+)?|3(0[0-9]{3}(\\.[0-9]+)?|1([0-2][0-9]{2}(\\.[0-9]+)?|3([0-3][0-9]{1}(\\.[0-9]+)?|4([0-7](\\.[0-9]+)?|8(\\.[0-5][0-9]*|\\.6([0-1][0-9]*|2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?)?)))))))))))[eE]\\+?0*298|(1([0-6][0-9]{10}(\\.[0-9]+)?|7([0-8][0-9]{9}(\\.[0-9]+)?|9([0-6][0-9]{8}(\\.[0-9]+)?|7([0-5][0-9]{7}(\\.[0-9]+)?|6([0-8][0-9]{6}(\\.[0-9]+)?|9([0-2][0-9]{5}(\\.[0-9]+)?|3(0[0-9]{4}(\\.[0-9]+)?|1([0-2][0-9]{3}(\\.[0-9]+)?|3([0-3][0-9]{2}(\\.[0-9]+)?|4([0-7][0-9]{1}(\\.[0-9]+)?|8([0-5](\\.[0-9]+)?|6(\\.[0-1][0-9]*|\\.2([0-2][0-9]*|3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)?))))))))))))[eE]\\+?0*297|(1([0-6][0-9]{11}(\\.[0-9]+)?|7([0-8][0-9]{10}(\\.[0-9]+)?|9([0-6][0-9]{9}(\\.[0-9]+)?|7([0-5][0-9]{8}(\\.[0-9]+)?|6([0-8][0-9]{7}(\\.[0-9]+)?|9([0-2][0-9]{6}(\\.[0-9]+)?|3(0[0-9]{5}(\\.[0-9]+)?|1([0-2][0-9]{4}(\\.[0-9]+)?|3([0-3][0-9]{3}(\\.[0-9]+)?|4([0-7][0-9]{2}(\\.[0-9]+)?|8([0-5][0-9]{1}(\\.[0-9]+)?|6([0-1](\\.[0-9]+)?|2(\\.[0-2][0-9]*|\\.3(0[0-9]*|1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?)?)))))))))))))[eE]\\+?0*296|(1([0-6][0-9]{12}(\\.[0-9]+)?|7([0-8][0-9]{11}(\\.[0-9]+)?|9([0-6][0-9]{10}(\\.[0-9]+)?|7([0-5][0-9]{9}(\\.[0-9]+)?|6([0-8][0-9]{8}(\\.[0-9]+)?|9([0-2][0-9]{7}(\\.[0-9]+)?|3(0[0-9]{6}(\\.[0-9]+)?|1([0-2][0-9]{5}(\\.[0-9]+)?|3([0-3][0-9]{4}(\\.[0-9]+)?|4([0-7][0-9]{3}(\\.[0-9]+)?|8([0-5][0-9]{2}(\\.[0-9]+)?|6([0-1][0-9]{1}(\\.[0-9]+)?|2([0-2](\\.[0-9]+)?|3(\\.0[0-9]*|\\.1([0-4][0-9]*|5([0-7][0-9]*|8)?)?)?))))))))))))))[eE]\\+?0*295|(1([0-6][0-9]{13}(\\.[0-9]+)?|7([0-8][0-9]{12}(\\.[0-9]+)?|9([0-6][0-9]{11}(\\.[0-9]+)?|7([0-5][0-9]{10}(\\.[0-9]+)?|6([0-8][0-9]{9}(\\.[0-9]+)?|9([0-2][0-9]{8}(\\.[0-9]+)?|3(0[0-9]{7}(\\.[0-9]+)?|1([0-2][0-9]{6}(\\.[0-9]+)?|3([0-3][0-9]{5}(\\.[0-9]+)?|4([0-7][0-9]{4}(\\.[0-9]+)?|8([0-5][0-9]{3}(\\.[0-9]+)?|6([0-1][0-9]{2}(\\.[0-9]+)?|2([0-2][0-9]{1}(\\.[0-9]+)?|3(0(\\.[0-9]+)?|1(\\.[0-4][0-9]*|\\.5([0-7][0-9]*|8)?)?)))))))))))))))[eE]\\+?0*294|(1([0-6][0-9]{14}(\\.[0-9]+)?|7([0-8][0-9]{13}(\\.[0-9]+)?|9([0-6][0-9]{12}(\\.[0-9]+)?|7([0-5][0-9]{11}(\\.[0-9]+)?|6([0-8][0-9]{10}(\\.[0-9]+)?|9([0-2][0-9]{9}(\\.[0-9]+)?|3(0[0-9]{8}(\\.[0-9]+)?|1([0-2][0-9]{7}(\\.[0-9]+)?|3([0-3][0-9]{6}(\\.[0-9]+)?|4([0-7][0-9]{5}(\\.[0-9]+)?|8([0-5][0-9]{4}(\\.[0-9]+)?|6([0-1][0-9]{3}(\\.[0-9]+)?|2([0-2][0-9]{2}(\\.[0-9]+)?|3(0[0-9]{1}(\\.[0-9]+)?|1([0-4](\\.[0-9]+)?|5(\\.[0-7][0-9]*|\\.8)?))))))))))))))))[eE]\\+?0*293|(1([0-6][0-9]{15}(\\.[0-9]+)?|7([0-8][0-9]{14}(\\.[0-9]+)?|9([0-6][0-9]{13}(\\.[0-9]+)?|7([0-5][0-9]{12}(\\.[0-9]+)?|6([0-8][0-9]{11}(\\.[0-9]+)?|9([0-2][0-9]{10}(\\.[0-9]+)?|3(0[0-9]{9}(\\.[0-9]+)?|1([0-2][0-9]{8}(\\.[0-9]+)?|3([0-3][0-9]{7}(\\.[0-9]+)?|4([0-7][0-9]{6}(\\.[0-9]+)?|8([0-5][0-9]{5}(\\.[0-9]+)?|6([0-1][0-9]{4}(\\.[0-9]+)?|2([0-2][0-9]{3}(\\.[0-9]+)?|3(0[0-9]{2}(\\.[0-9]+)?|1([0-4][0-9]{1}(\\.[0-9]+)?|5([0-7](\\.[0-9]+)?|8)))))))))))))))))[eE]\\+?0*292|([1-9][0-9]{0,15}|1[0-6][0-9]{15}|17[0-8][0-9]{14}|179[0-6][0-9]{13}|1797[0-5][0-9]{12}|17976[0-8][0-9]{11}|179769[0-2][0-9]{10}|17976930[0-9]{9}|17976931[0-2][0-9]{8}|179769313[0-3][0-9]{7}|1797693134[0-7][0-9]{6}|17976931348[0-5][0-9]{5}|179769313486[0-1][0-9]{4}|1797693134862[0-2][0-9]{3}|179769313486230[0-9]{2}|179769313486231[0-4][0-9]|1797693134862315[0-6]|17976931348623157)([0-9]{292}(\\.[0-9]+)?([eE][+-]?0+)?|[0-9]{1}(\\.[0-9]+)?[eE]\\+?0*291|[0-9]{2}(\\.[0-9]+)?[eE]\\+?0*290|[0-9]{3}(\\.[0-9]+)?[eE]\\+?0*289|[0-9]{4}(\\.[0-9]+)?[eE]\\+?0*288|[0-9]{5}(\\.[0-9]+)?[eE]\\+?0*287|[0-9]{6}(\\.[0-9]+)?[eE]\\+?0*286|[0-9]{7}(\\.[0-9]+)?[eE]\\+?0*285|[0-9]{8}(\\.[0-9]+)?[eE]\\+?0*284|[0-9]{9}(\\.[0-9]+)?[eE]\\+?0*283|[0-9]{10}(\\.[0-9]+)?[eE]\\+?0*282|[0-9]{11}(\\.[0-9]+)?[eE]\\+?0*281|[0-9]{12}(\\.[0-9]+)?[eE]\\+?0*280|[0-9]{13}(\\.[0-9]+)?[eE]\\+?0*279|[0-9]{14}(\\.[0-9]+)?[eE]\\+?0*278|[0-9]{15}(\\.[0-9]+)?[eE]\\+?0*277|[0-9]{16}(\\.[0-9]+)?[eE]\\+?0*276|[0-9]{17}(\\.[0-9]+)?[eE]\\+?0*275|[0-9]{18}(\\.[0-9]+)?[eE]\\+?0*274|[0-9]{19}(\\.[0-9]+)?[eE]\\+?0*273|[0-9]{20}(\\.[0-9]+)?[eE]\\+?0*272|[0-9]{21}(\\.[0-9]+)?[eE]\\+?0*271|[0-9]{22}(\\.[0-9]+)?[eE]\\+?0*270|[0-9]{23}(\\.[0-9]+)?[eE]\\+?0*269)))$",
+            "oneOf": [
+                {
+                    "type": "number"
+                },
+                {
+                    "type": "string"
+                }
+            ]
+        },
+        "float_value": {
+            "pattern": "^(NaN|-?Infinity|-?(0(\\.0+)?([eE][+-]?[0-9]+)?|(0|[1-9][0-9]{0,37})(\\.[0-9]+)?([eE](-[0-9]+|\\+?0+))?|[1-9](\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-6]|37)|[1-9][0-9]{1}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-5]|36)|[1-9][0-9]{2}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-4]|35)|[1-9][0-9]{3}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-3]|34)|[1-9][0-9]{4}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-2]|33)|[1-9][0-9]{5}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-1]|32)|[1-9][0-9]{6}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|30|31)|[1-9][0-9]{7}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|[1-2][0-9]|30)|[1-9][0-9]{8}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-8]|29)|[1-9][0-9]{9}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-7]|28)|[1-9][0-9]{10}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-6]|27)|[1-9][0-9]{11}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-5]|26)|[1-9][0-9]{12}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-4]|25)|[1-9][0-9]{13}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-3]|24)|[1-9][0-9]{14}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-2]|23)|[1-9][0-9]{15}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|2[0-1]|22)|[1-9][0-9]{16}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|20|21)|[1-9][0-9]{17}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-9]|20)|[1-9][0-9]{18}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-8]|19)|[1-9][0-9]{19}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-7]|18)|[1-9][0-9]{20}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-6]|17)|[1-9][0-9]{21}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-5]|16)|[1-9][0-9]{22}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-4]|15)|[1-9][0-9]{23}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-3]|14)|[1-9][0-9]{24}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-2]|13)|[1-9][0-9]{25}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|1[0-1]|12)|[1-9][0-9]{26}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|10|11)|[1-9][0-9]{27}(\\.[0-9]+)?[eE]\\+?0*(0|[1-9]|10)|[1-9][0-9]{28}(\\.[0-9]+)?[eE]\\+?0*(0|[1-8]|9)|[1-9][0-9]{29}(\\.[0-9]+)?[eE]\\+?0*(0|[1-7]|8)|[1-9][0-9]{30}(\\.[0-9]+)?[eE]\\+?0*(0|[1-6]|7)|[1-9][0-9]{31}(\\.[0-9]+)?[eE]\\+?0*(0|[1-5]|6)|[1-9][0-9]{32}(\\.[0-9]+)?[eE]\\+?0*(0|[1-4]|5)|[1-9][0-9]{33}(\\.[0-9]+)?[eE]\\+?0*(0|[1-3]|4)|[1-9][0-9]{34}(\\.[0-9]+)?[eE]\\+?0*(0|[1-2]|3)|[1-9][0-9]{35}(\\.[0-9]+)?[eE]\\+?0*(0|1|2)|[1-9][0-9]{36}(\\.[0-9]+)?[eE]\\+?0*(0|1)|[1-9][0-9]{37}(\\.[0-9]+)?[eE]\\+?0*(0)|0\\.0{0}([0-9]+[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-7]|38)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*39)|0\\.0{1}([0-9]+[eE]\\+?0*(0|[1-9]|[1-2][0-9]|3[0-8]|39)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*40)|0\\.0{2}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|40)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*41)|0\\.0{3}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|40|41)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*42)|0\\.0{4}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-1]|42)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*43)|0\\.0{5}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-2]|43)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*44)|0\\.0{6}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-3]|44)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*45)|0\\.0{7}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-4]|45)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*46)|0\\.0{8}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-5]|46)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*47)|0\\.0{9}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-6]|47)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*48)|0\\.0{10}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-7]|48)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*49)|0\\.0{11}([0-9]+[eE]\\+?0*(0|[1-9]|[1-3][0-9]|4[0-8]|49)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*50)|0\\.0{12}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|50)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*51)|0\\.0{13}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|50|51)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*52)|0\\.0{14}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-1]|52)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*53)|0\\.0{15}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-2]|53)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*54)|0\\.0{16}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-3]|54)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*55)|0\\.0{17}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-4]|55)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*56)|0\\.0{18}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-5]|56)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*57)|0\\.0{19}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-6]|57)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*58)|0\\.0{20}([0-9]+[eE]\\+?0*(0|[1-9]|[1-4][0-9]|5[0-7]|58)|([0-2][0-9]*|3([0-3][0-9]*|4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*)?)?)?)?)?)?)?)?)[eE]\\+?0*59)|([1-2](\\.[0-9]+)?|3(\\.[0-3][0-9]*|\\.4(0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*|6)?)?)?)?)?)?)?)?)[eE]\\+?0*38|([1-2][0-9]{1}(\\.[0-9]+)?|3([0-3](\\.[0-9]+)?|4(\\.0([0-1][0-9]*|2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*|6)?)?)?)?)?)?)?))[eE]\\+?0*37|([1-2][0-9]{2}(\\.[0-9]+)?|3([0-3][0-9]{1}(\\.[0-9]+)?|4(0(\\.[0-1][0-9]*|\\.2([0-7][0-9]*|8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*|6)?)?)?)?)?)?)))[eE]\\+?0*36|([1-2][0-9]{3}(\\.[0-9]+)?|3([0-3][0-9]{2}(\\.[0-9]+)?|4(0([0-1](\\.[0-9]+)?|2(\\.[0-7][0-9]*|\\.8([0-1][0-9]*|2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*|6)?)?)?)?)?))))[eE]\\+?0*35|([1-2][0-9]{4}(\\.[0-9]+)?|3([0-3][0-9]{3}(\\.[0-9]+)?|4(0([0-1][0-9]{1}(\\.[0-9]+)?|2([0-7](\\.[0-9]+)?|8(\\.[0-1][0-9]*|\\.2([0-2][0-9]*|3([0-4][0-9]*|5([0-5][0-9]*|6)?)?)?)?)))))[eE]\\+?0*34|([1-2][0-9]{5}(\\.[0-9]+)?|3([0-3][0-9]{4}(\\.[0-9]+)?|4(0([0-1][0-9]{2}(\\.[0-9]+)?|2([0-7][0-9]{1}(\\.[0-9]+)?|8([0-1](\\.[0-9]+)?|2(\\.[0-2][0-9]*|\\.3([0-4][0-9]*|5([0-5][0-9]*|6)?)?)?))))))[eE]\\+?0*33|([1-2][0-9]{6}(\\.[0-9]+)?|3([0-3][0-9]{5}(\\.[0-9]+)?|4(0([0-1][0-9]{3}(\\.[0-9]+)?|2([0-7][0-9]{2}(\\.[0-9]+)?|8([0-1][0-9]{1}(\\.[0-9]+)?|2([0-2](\\.[0-9]+)?|3(\\.[0-4][0-9]*|\\.5([0-5][0-9]*|6)?)?)))))))[eE]\\+?0*32|([1-2][0-9]{7}(\\.[0-9]+)?|3([0-3][0-9]{6}(\\.[0-9]+)?|4(0([0-1][0-9]{4}(\\.[0-9]+)?|2([0-7][0-9]{3}(\\.[0-9]+)?|8([0-1][0-9]{2}(\\.[0-9]+)?|2([0-2][0-9]{1}(\\.[0-9]+)?|3([0-4](\\.[0-9]+)?|5(\\.[0-5][0-9]*|\\.6)?))))))))[eE]\\+?0*31|([1-2][0-9]{8}(\\.[0-9]+)?|3([0-3][0-9]{7}(\\.[0-9]+)?|4(0([0-1][0-9]{5}(\\.[0-9]+)?|2([0-7][0-9]{4}(\\.[0-9]+)?|8([0-1][0-9]{3}(\\.[0-9]+)?|2([0-2][0-9]{2}(\\.[0-9]+)?|3([0-4][0-9]{1}(\\.[0-9]+)?|5([0-5](\\.[0-9]+)?|6)))))))))[eE]\\+?0*30|([1-9][0-9]{0,7}|[1-2][0-9]{8}|3[0-3][0-9]{7}|340[0-1][0-9]{5}|3402[0-7][0-9]{4}|34028[0-1][0-9]{3}|340282[0-2][0-9]{2}|3402823[0-4][0-9]|34028235[0-4]|340282355)([0-9]{30}(\\.[0-9]+)?([eE][+-]?0+)?|[0-9]{1}(\\.[0-9]+)?[eE]\\+?0*29|[0-9]{2}(\\.[0-9]+)?[eE]\\+?0*28|[0-9]{3}(\\.[0-9]+)?[eE]\\+?0*27|[0-9]{4}(\\.[0-9]+)?[eE]\\+?0*26|[0-9]{5}(\\.[0-9]+)?[eE]\\+?0*25|[0-9]{6}(\\.[0-9]+)?[eE]\\+?0*24|[0-9]{7}(\\.[0-9]+)?[eE]\\+?0*23|[0-9]{8}(\\.[0-9]+)?[eE]\\+?0*22|[0-9]{9}(\\.[0-9]+)?[eE]\\+?0*21|[0-9]{10}(\\.[0-9]+)?[eE]\\+?0*20|[0-9]{11}(\\.[0-9]+)?[eE]\\+?0*19|[0-9]{12}(\\.[0-9]+)?[eE]\\+?0*18|[0-9]{13}(\\.[0-9]+)?[eE]\\+?0*17|[0-9]{14}(\\.[0-9]+)?[eE]\\+?0*16|[0-9]{15}(\\.[0-9]+)?[eE]\\+?0*15|[0-9]{16}(\\.[0-9]+)?[eE]\\+?0*14|[0-9]{17}(\\.[0-9]+)?[eE]\\+?0*13|[0-9]{18}(\\.[0-9]+)?[eE]\\+?0*12|[0-9]{19}(\\.[0-9]+)?[eE]\\+?0*11|[0-9]{20}(\\.[0-9]+)?[eE]\\+?0*10|[0-9]{21}(\\.[0-9]+)?[eE]\\+?0*9|[0-9]{22}(\\.[0-9]+)?[eE]\\+?0*8|[0-9]{23}(\\.[0-9]+)?[eE]\\+?0*7|[0-9]{24}(\\.[0-9]+)?[eE]\\+?0*6|[0-9]{25}(\\.[0-9]+)?[eE]\\+?0*5|[0-9]{26}(\\.[0-9]+)?[eE]\\+?0*4|[0-9]{27}(\\.[0-9]+)?[eE]\\+?0*3|[0-9]{28}(\\.[0-9]+)?[eE]\\+?0*2|[0-9]{29}(\\.[0-9]+)?[eE]\\+?0*1)))$",
+            "oneOf": [
+                {
+                    "type": "number"
+                },
+                {
+                    "type": "string"
+                }
+            ]
+        },
+        "int32_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,8}|1[0-9]{9}|20[0-9]{8}|21[0-3][0-9]{7}|214[0-6][0-9]{6}|2147[0-3][0-9]{5}|21474[0-7][0-9]{4}|214748[0-2][0-9]{3}|2147483[0-5][0-9]{2}|21474836[0-3][0-9]|214748364[0-6]|2147483647)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|1[0-9]{8}|20[0-9]{7}|21[0-3][0-9]{6}|214[0-6][0-9]{5}|2147[0-3][0-9]{4}|21474[0-7][0-9]{3}|214748[0-2][0-9]{2}|2147483[0-5][0-9]|21474836[0-2]|214748363)(\\.[0-9]{1,1}0*)?|214748364(\\.([0-6]|7)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|1[0-9]{7}|20[0-9]{6}|21[0-3][0-9]{5}|214[0-6][0-9]{4}|2147[0-3][0-9]{3}|21474[0-7][0-9]{2}|214748[0-2][0-9]|2147483[0-4]|21474835)(\\.[0-9]{1,2}0*)?|21474836(\\.([0-3][0-9]{0,1}|4([0-6]|7)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|1[0-9]{6}|20[0-9]{5}|21[0-3][0-9]{4}|214[0-6][0-9]{3}|2147[0-3][0-9]{2}|21474[0-7][0-9]|214748[0-1]|2147482)(\\.[0-9]{1,3}0*)?|2147483(\\.([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|1[0-9]{5}|20[0-9]{4}|21[0-3][0-9]{3}|214[0-6][0-9]{2}|2147[0-3][0-9]|21474[0-6]|214747)(\\.[0-9]{1,4}0*)?|214748(\\.([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|1[0-9]{4}|20[0-9]{3}|21[0-3][0-9]{2}|214[0-6][0-9]|2147[0-2]|21473)(\\.[0-9]{1,5}0*)?|21474(\\.([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|1[0-9]{3}|20[0-9]{2}|21[0-3][0-9]|214[0-5]|2146)(\\.[0-9]{1,6}0*)?|2147(\\.([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|1[0-9]{2}|20[0-9]|21[0-2]|213)(\\.[0-9]{1,7}0*)?|214(\\.([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|1[0-9]|20)(\\.[0-9]{1,8}0*)?|21(\\.([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|1)(\\.[0-9]{1,9}0*)?|2(\\.(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20|-(([1-9][0-9]{0,8}|1[0-9]{9}|20[0-9]{8}|21[0-3][0-9]{7}|214[0-6][0-9]{6}|2147[0-3][0-9]{5}|21474[0-7][0-9]{4}|214748[0-2][0-9]{3}|2147483[0-5][0-9]{2}|21474836[0-3][0-9]|214748364[0-7]|2147483648)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|1[0-9]{8}|20[0-9]{7}|21[0-3][0-9]{6}|214[0-6][0-9]{5}|2147[0-3][0-9]{4}|21474[0-7][0-9]{3}|214748[0-2][0-9]{2}|2147483[0-5][0-9]|21474836[0-2]|214748363)(\\.[0-9]{1,1}0*)?|214748364(\\.([0-7]|8)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|1[0-9]{7}|20[0-9]{6}|21[0-3][0-9]{5}|214[0-6][0-9]{4}|2147[0-3][0-9]{3}|21474[0-7][0-9]{2}|214748[0-2][0-9]|2147483[0-4]|21474835)(\\.[0-9]{1,2}0*)?|21474836(\\.([0-3][0-9]{0,1}|4([0-7]|8)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|1[0-9]{6}|20[0-9]{5}|21[0-3][0-9]{4}|214[0-6][0-9]{3}|2147[0-3][0-9]{2}|21474[0-7][0-9]|214748[0-1]|2147482)(\\.[0-9]{1,3}0*)?|2147483(\\.([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|1[0-9]{5}|20[0-9]{4}|21[0-3][0-9]{3}|214[0-6][0-9]{2}|2147[0-3][0-9]|21474[0-6]|214747)(\\.[0-9]{1,4}0*)?|214748(\\.([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|1[0-9]{4}|20[0-9]{3}|21[0-3][0-9]{2}|214[0-6][0-9]|2147[0-2]|21473)(\\.[0-9]{1,5}0*)?|21474(\\.([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|1[0-9]{3}|20[0-9]{2}|21[0-3][0-9]|214[0-5]|2146)(\\.[0-9]{1,6}0*)?|2147(\\.([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|1[0-9]{2}|20[0-9]|21[0-2]|213)(\\.[0-9]{1,7}0*)?|214(\\.([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|1[0-9]|20)(\\.[0-9]{1,8}0*)?|21(\\.([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|1)(\\.[0-9]{1,9}0*)?|2(\\.(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20))$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "int64_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,17}|[1-8][0-9]{18}|9[0-1][0-9]{17}|92[0-1][0-9]{16}|922[0-2][0-9]{15}|9223[0-2][0-9]{14}|92233[0-6][0-9]{13}|922337[0-1][0-9]{12}|92233720[0-2][0-9]{10}|922337203[0-5][0-9]{9}|9223372036[0-7][0-9]{8}|92233720368[0-4][0-9]{7}|922337203685[0-3][0-9]{6}|9223372036854[0-6][0-9]{5}|92233720368547[0-6][0-9]{4}|922337203685477[0-4][0-9]{3}|9223372036854775[0-7][0-9]{2}|922337203685477580[0-6]|9223372036854775807)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,16}|[1-8][0-9]{17}|9[0-1][0-9]{16}|92[0-1][0-9]{15}|922[0-2][0-9]{14}|9223[0-2][0-9]{13}|92233[0-6][0-9]{12}|922337[0-1][0-9]{11}|92233720[0-2][0-9]{9}|922337203[0-5][0-9]{8}|9223372036[0-7][0-9]{7}|92233720368[0-4][0-9]{6}|922337203685[0-3][0-9]{5}|9223372036854[0-6][0-9]{4}|92233720368547[0-6][0-9]{3}|922337203685477[0-4][0-9]{2}|9223372036854775[0-6][0-9]|92233720368547757[0-8]|922337203685477579)(\\.[0-9]{1,1}0*)?|922337203685477580(\\.([0-6]|7)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,15}|[1-8][0-9]{16}|9[0-1][0-9]{15}|92[0-1][0-9]{14}|922[0-2][0-9]{13}|9223[0-2][0-9]{12}|92233[0-6][0-9]{11}|922337[0-1][0-9]{10}|92233720[0-2][0-9]{8}|922337203[0-5][0-9]{7}|9223372036[0-7][0-9]{6}|92233720368[0-4][0-9]{5}|922337203685[0-3][0-9]{4}|9223372036854[0-6][0-9]{3}|92233720368547[0-6][0-9]{2}|922337203685477[0-4][0-9]|9223372036854775[0-6]|92233720368547757)(\\.[0-9]{1,2}0*)?|92233720368547758(\\.(0([0-6]|7)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,14}|[1-8][0-9]{15}|9[0-1][0-9]{14}|92[0-1][0-9]{13}|922[0-2][0-9]{12}|9223[0-2][0-9]{11}|92233[0-6][0-9]{10}|922337[0-1][0-9]{9}|92233720[0-2][0-9]{7}|922337203[0-5][0-9]{6}|9223372036[0-7][0-9]{5}|92233720368[0-4][0-9]{4}|922337203685[0-3][0-9]{3}|9223372036854[0-6][0-9]{2}|92233720368547[0-6][0-9]|922337203685477[0-3]|9223372036854774)(\\.[0-9]{1,3}0*)?|9223372036854775(\\.([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,13}|[1-8][0-9]{14}|9[0-1][0-9]{13}|92[0-1][0-9]{12}|922[0-2][0-9]{11}|9223[0-2][0-9]{10}|92233[0-6][0-9]{9}|922337[0-1][0-9]{8}|92233720[0-2][0-9]{6}|922337203[0-5][0-9]{5}|9223372036[0-7][0-9]{4}|92233720368[0-4][0-9]{3}|922337203685[0-3][0-9]{2}|9223372036854[0-6][0-9]|92233720368547[0-5]|922337203685476)(\\.[0-9]{1,4}0*)?|922337203685477(\\.([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,12}|[1-8][0-9]{13}|9[0-1][0-9]{12}|92[0-1][0-9]{11}|922[0-2][0-9]{10}|9223[0-2][0-9]{9}|92233[0-6][0-9]{8}|922337[0-1][0-9]{7}|92233720[0-2][0-9]{5}|922337203[0-5][0-9]{4}|9223372036[0-7][0-9]{3}|92233720368[0-4][0-9]{2}|922337203685[0-3][0-9]|9223372036854[0-5]|92233720368546)(\\.[0-9]{1,5}0*)?|92233720368547(\\.([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,11}|[1-8][0-9]{12}|9[0-1][0-9]{11}|92[0-1][0-9]{10}|922[0-2][0-9]{9}|9223[0-2][0-9]{8}|92233[0-6][0-9]{7}|922337[0-1][0-9]{6}|92233720[0-2][0-9]{4}|922337203[0-5][0-9]{3}|9223372036[0-7][0-9]{2}|92233720368[0-4][0-9]|922337203685[0-2]|9223372036853)(\\.[0-9]{1,6}0*)?|9223372036854(\\.([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,10}|[1-8][0-9]{11}|9[0-1][0-9]{10}|92[0-1][0-9]{9}|922[0-2][0-9]{8}|9223[0-2][0-9]{7}|92233[0-6][0-9]{6}|922337[0-1][0-9]{5}|92233720[0-2][0-9]{3}|922337203[0-5][0-9]{2}|9223372036[0-7][0-9]|92233720368[0-3]|922337203684)(\\.[0-9]{1,7}0*)?|922337203685(\\.([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,9}|[1-8][0-9]{10}|9[0-1][0-9]{9}|92[0-1][0-9]{8}|922[0-2][0-9]{7}|9223[0-2][0-9]{6}|92233[0-6][0-9]{5}|922337[0-1][0-9]{4}|92233720[0-2][0-9]{2}|922337203[0-5][0-9]|9223372036[0-6]|92233720367)(\\.[0-9]{1,8}0*)?|92233720368(\\.([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,8}|[1-8][0-9]{9}|9[0-1][0-9]{8}|92[0-1][0-9]{7}|922[0-2][0-9]{6}|9223[0-2][0-9]{5}|92233[0-6][0-9]{4}|922337[0-1][0-9]{3}|92233720[0-2][0-9]|922337203[0-4]|9223372035)(\\.[0-9]{1,9}0*)?|9223372036(\\.([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,7}|[1-8][0-9]{8}|9[0-1][0-9]{7}|92[0-1][0-9]{6}|922[0-2][0-9]{5}|9223[0-2][0-9]{4}|92233[0-6][0-9]{3}|922337[0-1][0-9]{2}|92233720[0-1]|922337202)(\\.[0-9]{1,10}0*)?|922337203(\\.([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,6}|[1-8][0-9]{7}|9[0-1][0-9]{6}|92[0-1][0-9]{5}|922[0-2][0-9]{4}|9223[0-2][0-9]{3}|92233[0-6][0-9]{2}|9223370[0-9]|9223371[0-8]|92233719)(\\.[0-9]{1,11}0*)?|92233720(\\.([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,5}|[1-8][0-9]{6}|9[0-1][0-9]{5}|92[0-1][0-9]{4}|922[0-2][0-9]{3}|9223[0-2][0-9]{2}|92233[0-6][0-9]|9223370|9223371)(\\.[0-9]{1,12}0*)?|9223372(\\.(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,4}|[1-8][0-9]{5}|9[0-1][0-9]{4}|92[0-1][0-9]{3}|922[0-2][0-9]{2}|9223[0-2][0-9]|92233[0-5]|922336)(\\.[0-9]{1,13}0*)?|922337(\\.([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,3}|[1-8][0-9]{4}|9[0-1][0-9]{3}|92[0-1][0-9]{2}|922[0-2][0-9]|9223[0-1]|92232)(\\.[0-9]{1,14}0*)?|92233(\\.([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,2}|[1-8][0-9]{3}|9[0-1][0-9]{2}|92[0-1][0-9]|922[0-1]|9222)(\\.[0-9]{1,15}0*)?|9223(\\.([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,1}|[1-8][0-9]{2}|9[0-1][0-9]|920|921)(\\.[0-9]{1,16}0*)?|922(\\.([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9]|[1-8][0-9]|90|91)(\\.[0-9]{1,17}0*)?|92(\\.([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-7]|8)(\\.[0-9]{1,18}0*)?|9(\\.([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20|-(([1-9][0-9]{0,17}|[1-8][0-9]{18}|9[0-1][0-9]{17}|92[0-1][0-9]{16}|922[0-2][0-9]{15}|9223[0-2][0-9]{14}|92233[0-6][0-9]{13}|922337[0-1][0-9]{12}|92233720[0-2][0-9]{10}|922337203[0-5][0-9]{9}|9223372036[0-7][0-9]{8}|92233720368[0-4][0-9]{7}|922337203685[0-3][0-9]{6}|9223372036854[0-6][0-9]{5}|92233720368547[0-6][0-9]{4}|922337203685477[0-4][0-9]{3}|9223372036854775[0-7][0-9]{2}|922337203685477580[0-7]|9223372036854775808)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,16}|[1-8][0-9]{17}|9[0-1][0-9]{16}|92[0-1][0-9]{15}|922[0-2][0-9]{14}|9223[0-2][0-9]{13}|92233[0-6][0-9]{12}|922337[0-1][0-9]{11}|92233720[0-2][0-9]{9}|922337203[0-5][0-9]{8}|9223372036[0-7][0-9]{7}|92233720368[0-4][0-9]{6}|922337203685[0-3][0-9]{5}|9223372036854[0-6][0-9]{4}|92233720368547[0-6][0-9]{3}|922337203685477[0-4][0-9]{2}|9223372036854775[0-6][0-9]|92233720368547757[0-8]|922337203685477579)(\\.[0-9]{1,1}0*)?|922337203685477580(\\.([0-7]|8)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,15}|[1-8][0-9]{16}|9[0-1][0-9]{15}|92[0-1][0-9]{14}|922[0-2][0-9]{13}|9223[0-2][0-9]{12}|92233[0-6][0-9]{11}|922337[0-1][0-9]{10}|92233720[0-2][0-9]{8}|922337203[0-5][0-9]{7}|9223372036[0-7][0-9]{6}|92233720368[0-4][0-9]{5}|922337203685[0-3][0-9]{4}|9223372036854[0-6][0-9]{3}|92233720368547[0-6][0-9]{2}|922337203685477[0-4][0-9]|9223372036854775[0-6]|92233720368547757)(\\.[0-9]{1,2}0*)?|92233720368547758(\\.(0([0-7]|8)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,14}|[1-8][0-9]{15}|9[0-1][0-9]{14}|92[0-1][0-9]{13}|922[0-2][0-9]{12}|9223[0-2][0-9]{11}|92233[0-6][0-9]{10}|922337[0-1][0-9]{9}|92233720[0-2][0-9]{7}|922337203[0-5][0-9]{6}|9223372036[0-7][0-9]{5}|92233720368[0-4][0-9]{4}|922337203685[0-3][0-9]{3}|9223372036854[0-6][0-9]{2}|92233720368547[0-6][0-9]|922337203685477[0-3]|9223372036854774)(\\.[0-9]{1,3}0*)?|9223372036854775(\\.([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,13}|[1-8][0-9]{14}|9[0-1][0-9]{13}|92[0-1][0-9]{12}|922[0-2][0-9]{11}|9223[0-2][0-9]{10}|92233[0-6][0-9]{9}|922337[0-1][0-9]{8}|92233720[0-2][0-9]{6}|922337203[0-5][0-9]{5}|9223372036[0-7][0-9]{4}|92233720368[0-4][0-9]{3}|922337203685[0-3][0-9]{2}|9223372036854[0-6][0-9]|92233720368547[0-5]|922337203685476)(\\.[0-9]{1,4}0*)?|922337203685477(\\.([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,12}|[1-8][0-9]{13}|9[0-1][0-9]{12}|92[0-1][0-9]{11}|922[0-2][0-9]{10}|9223[0-2][0-9]{9}|92233[0-6][0-9]{8}|922337[0-1][0-9]{7}|92233720[0-2][0-9]{5}|922337203[0-5][0-9]{4}|9223372036[0-7][0-9]{3}|92233720368[0-4][0-9]{2}|922337203685[0-3][0-9]|9223372036854[0-5]|92233720368546)(\\.[0-9]{1,5}0*)?|92233720368547(\\.([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,11}|[1-8][0-9]{12}|9[0-1][0-9]{11}|92[0-1][0-9]{10}|922[0-2][0-9]{9}|9223[0-2][0-9]{8}|92233[0-6][0-9]{7}|922337[0-1][0-9]{6}|92233720[0-2][0-9]{4}|922337203[0-5][0-9]{3}|9223372036[0-7][0-9]{2}|92233720368[0-4][0-9]|922337203685[0-2]|9223372036853)(\\.[0-9]{1,6}0*)?|9223372036854(\\.([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,10}|[1-8][0-9]{11}|9[0-1][0-9]{10}|92[0-1][0-9]{9}|922[0-2][0-9]{8}|9223[0-2][0-9]{7}|92233[0-6][0-9]{6}|922337[0-1][0-9]{5}|92233720[0-2][0-9]{3}|922337203[0-5][0-9]{2}|9223372036[0-7][0-9]|92233720368[0-3]|922337203684)(\\.[0-9]{1,7}0*)?|922337203685(\\.([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,9}|[1-8][0-9]{10}|9[0-1][0-9]{9}|92[0-1][0-9]{8}|922[0-2][0-9]{7}|9223[0-2][0-9]{6}|92233[0-6][0-9]{5}|922337[0-1][0-9]{4}|92233720[0-2][0-9]{2}|922337203[0-5][0-9]|9223372036[0-6]|92233720367)(\\.[0-9]{1,8}0*)?|92233720368(\\.([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,8}|[1-8][0-9]{9}|9[0-1][0-9]{8}|92[0-1][0-9]{7}|922[0-2][0-9]{6}|9223[0-2][0-9]{5}|92233[0-6][0-9]{4}|922337[0-1][0-9]{3}|92233720[0-2][0-9]|922337203[0-4]|9223372035)(\\.[0-9]{1,9}0*)?|9223372036(\\.([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,7}|[1-8][0-9]{8}|9[0-1][0-9]{7}|92[0-1][0-9]{6}|922[0-2][0-9]{5}|9223[0-2][0-9]{4}|92233[0-6][0-9]{3}|922337[0-1][0-9]{2}|92233720[0-1]|922337202)(\\.[0-9]{1,10}0*)?|922337203(\\.([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,6}|[1-8][0-9]{7}|9[0-1][0-9]{6}|92[0-1][0-9]{5}|922[0-2][0-9]{4}|9223[0-2][0-9]{3}|92233[0-6][0-9]{2}|9223370[0-9]|9223371[0-8]|92233719)(\\.[0-9]{1,11}0*)?|92233720(\\.([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,5}|[1-8][0-9]{6}|9[0-1][0-9]{5}|92[0-1][0-9]{4}|922[0-2][0-9]{3}|9223[0-2][0-9]{2}|92233[0-6][0-9]|9223370|9223371)(\\.[0-9]{1,12}0*)?|9223372(\\.(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,4}|[1-8][0-9]{5}|9[0-1][0-9]{4}|92[0-1][0-9]{3}|922[0-2][0-9]{2}|9223[0-2][0-9]|92233[0-5]|922336)(\\.[0-9]{1,13}0*)?|922337(\\.([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,3}|[1-8][0-9]{4}|9[0-1][0-9]{3}|92[0-1][0-9]{2}|922[0-2][0-9]|9223[0-1]|92232)(\\.[0-9]{1,14}0*)?|92233(\\.([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,2}|[1-8][0-9]{3}|9[0-1][0-9]{2}|92[0-1][0-9]|922[0-1]|9222)(\\.[0-9]{1,15}0*)?|9223(\\.([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,1}|[1-8][0-9]{2}|9[0-1][0-9]|920|921)(\\.[0-9]{1,16}0*)?|922(\\.([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9]|[1-8][0-9]|90|91)(\\.[0-9]{1,17}0*)?|92(\\.([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-7]|8)(\\.[0-9]{1,18}0*)?|9(\\.([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20))$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "uint32_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,8}|[1-3][0-9]{9}|4[0-1][0-9]{8}|42[0-8][0-9]{7}|429[0-3][0-9]{6}|4294[0-8][0-9]{5}|42949[0-5][0-9]{4}|429496[0-6][0-9]{3}|4294967[0-1][0-9]{2}|42949672[0-8][0-9]|429496729[0-4]|4294967295)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|[1-3][0-9]{8}|4[0-1][0-9]{7}|42[0-8][0-9]{6}|429[0-3][0-9]{5}|4294[0-8][0-9]{4}|42949[0-5][0-9]{3}|429496[0-6][0-9]{2}|4294967[0-1][0-9]|42949672[0-7]|429496728)(\\.[0-9]{1,1}0*)?|429496729(\\.([0-4]|5)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|[1-3][0-9]{7}|4[0-1][0-9]{6}|42[0-8][0-9]{5}|429[0-3][0-9]{4}|4294[0-8][0-9]{3}|42949[0-5][0-9]{2}|429496[0-6][0-9]|42949670|42949671)(\\.[0-9]{1,2}0*)?|42949672(\\.([0-8][0-9]{0,1}|9([0-4]|5)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|[1-3][0-9]{6}|4[0-1][0-9]{5}|42[0-8][0-9]{4}|429[0-3][0-9]{3}|4294[0-8][0-9]{2}|42949[0-5][0-9]|429496[0-5]|4294966)(\\.[0-9]{1,3}0*)?|4294967(\\.([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|[1-3][0-9]{5}|4[0-1][0-9]{4}|42[0-8][0-9]{3}|429[0-3][0-9]{2}|4294[0-8][0-9]|42949[0-4]|429495)(\\.[0-9]{1,4}0*)?|429496(\\.([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|[1-3][0-9]{4}|4[0-1][0-9]{3}|42[0-8][0-9]{2}|429[0-3][0-9]|4294[0-7]|42948)(\\.[0-9]{1,5}0*)?|42949(\\.([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|[1-3][0-9]{3}|4[0-1][0-9]{2}|42[0-8][0-9]|429[0-2]|4293)(\\.[0-9]{1,6}0*)?|4294(\\.([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|[1-3][0-9]{2}|4[0-1][0-9]|42[0-7]|428)(\\.[0-9]{1,7}0*)?|429(\\.([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|[1-3][0-9]|40|41)(\\.[0-9]{1,8}0*)?|42(\\.([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-2]|3)(\\.[0-9]{1,9}0*)?|4(\\.([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20)$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "uint64_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,18}|1[0-7][0-9]{18}|18[0-3][0-9]{17}|184[0-3][0-9]{16}|1844[0-5][0-9]{15}|18446[0-6][0-9]{14}|184467[0-3][0-9]{13}|1844674[0-3][0-9]{12}|184467440[0-6][0-9]{10}|1844674407[0-2][0-9]{9}|18446744073[0-6][0-9]{8}|1844674407370[0-8][0-9]{6}|18446744073709[0-4][0-9]{5}|184467440737095[0-4][0-9]{4}|18446744073709550[0-9]{3}|18446744073709551[0-5][0-9]{2}|1844674407370955160[0-9]|1844674407370955161[0-4]|18446744073709551615)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,17}|1[0-7][0-9]{17}|18[0-3][0-9]{16}|184[0-3][0-9]{15}|1844[0-5][0-9]{14}|18446[0-6][0-9]{13}|184467[0-3][0-9]{12}|1844674[0-3][0-9]{11}|184467440[0-6][0-9]{9}|1844674407[0-2][0-9]{8}|18446744073[0-6][0-9]{7}|1844674407370[0-8][0-9]{5}|18446744073709[0-4][0-9]{4}|184467440737095[0-4][0-9]{3}|18446744073709550[0-9]{2}|18446744073709551[0-5][0-9]|1844674407370955160)(\\.[0-9]{1,1}0*)?|1844674407370955161(\\.([0-4]|5)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,16}|1[0-7][0-9]{16}|18[0-3][0-9]{15}|184[0-3][0-9]{14}|1844[0-5][0-9]{13}|18446[0-6][0-9]{12}|184467[0-3][0-9]{11}|1844674[0-3][0-9]{10}|184467440[0-6][0-9]{8}|1844674407[0-2][0-9]{7}|18446744073[0-6][0-9]{6}|1844674407370[0-8][0-9]{4}|18446744073709[0-4][0-9]{3}|184467440737095[0-4][0-9]{2}|18446744073709550[0-9]|18446744073709551[0-4]|184467440737095515)(\\.[0-9]{1,2}0*)?|184467440737095516(\\.(0[0-9]{0,1}|1([0-4]|5)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,15}|1[0-7][0-9]{15}|18[0-3][0-9]{14}|184[0-3][0-9]{13}|1844[0-5][0-9]{12}|18446[0-6][0-9]{11}|184467[0-3][0-9]{10}|1844674[0-3][0-9]{9}|184467440[0-6][0-9]{7}|1844674407[0-2][0-9]{6}|18446744073[0-6][0-9]{5}|1844674407370[0-8][0-9]{3}|18446744073709[0-4][0-9]{2}|184467440737095[0-4][0-9]|18446744073709550)(\\.[0-9]{1,3}0*)?|18446744073709551(\\.([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,14}|1[0-7][0-9]{14}|18[0-3][0-9]{13}|184[0-3][0-9]{12}|1844[0-5][0-9]{11}|18446[0-6][0-9]{10}|184467[0-3][0-9]{9}|1844674[0-3][0-9]{8}|184467440[0-6][0-9]{6}|1844674407[0-2][0-9]{5}|18446744073[0-6][0-9]{4}|1844674407370[0-8][0-9]{2}|18446744073709[0-4][0-9]|184467440737095[0-3]|1844674407370954)(\\.[0-9]{1,4}0*)?|1844674407370955(\\.(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,13}|1[0-7][0-9]{13}|18[0-3][0-9]{12}|184[0-3][0-9]{11}|1844[0-5][0-9]{10}|18446[0-6][0-9]{9}|184467[0-3][0-9]{8}|1844674[0-3][0-9]{7}|184467440[0-6][0-9]{5}|1844674407[0-2][0-9]{4}|18446744073[0-6][0-9]{3}|1844674407370[0-8][0-9]|18446744073709[0-3]|184467440737094)(\\.[0-9]{1,5}0*)?|184467440737095(\\.([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,12}|1[0-7][0-9]{12}|18[0-3][0-9]{11}|184[0-3][0-9]{10}|1844[0-5][0-9]{9}|18446[0-6][0-9]{8}|184467[0-3][0-9]{7}|1844674[0-3][0-9]{6}|184467440[0-6][0-9]{4}|1844674407[0-2][0-9]{3}|18446744073[0-6][0-9]{2}|1844674407370[0-7]|18446744073708)(\\.[0-9]{1,6}0*)?|18446744073709(\\.([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,11}|1[0-7][0-9]{11}|18[0-3][0-9]{10}|184[0-3][0-9]{9}|1844[0-5][0-9]{8}|18446[0-6][0-9]{7}|184467[0-3][0-9]{6}|1844674[0-3][0-9]{5}|184467440[0-6][0-9]{3}|1844674407[0-2][0-9]{2}|18446744073[0-5][0-9]|184467440736[0-8]|1844674407369)(\\.[0-9]{1,7}0*)?|1844674407370(\\.([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,10}|1[0-7][0-9]{10}|18[0-3][0-9]{9}|184[0-3][0-9]{8}|1844[0-5][0-9]{7}|18446[0-6][0-9]{6}|184467[0-3][0-9]{5}|1844674[0-3][0-9]{4}|184467440[0-6][0-9]{2}|1844674407[0-2][0-9]|18446744073[0-5]|184467440736)(\\.[0-9]{1,8}0*)?|184467440737(\\.(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,9}|1[0-7][0-9]{9}|18[0-3][0-9]{8}|184[0-3][0-9]{7}|1844[0-5][0-9]{6}|18446[0-6][0-9]{5}|184467[0-3][0-9]{4}|1844674[0-3][0-9]{3}|184467440[0-6][0-9]|1844674407[0-1]|18446744072)(\\.[0-9]{1,9}0*)?|18446744073(\\.([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,8}|1[0-7][0-9]{8}|18[0-3][0-9]{7}|184[0-3][0-9]{6}|1844[0-5][0-9]{5}|18446[0-6][0-9]{4}|184467[0-3][0-9]{3}|1844674[0-3][0-9]{2}|184467440[0-5]|1844674406)(\\.[0-9]{1,10}0*)?|1844674407(\\.([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,7}|1[0-7][0-9]{7}|18[0-3][0-9]{6}|184[0-3][0-9]{5}|1844[0-5][0-9]{4}|18446[0-6][0-9]{3}|184467[0-3][0-9]{2}|1844674[0-2][0-9]|18446743[0-8]|184467439)(\\.[0-9]{1,11}0*)?|184467440(\\.([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,6}|1[0-7][0-9]{6}|18[0-3][0-9]{5}|184[0-3][0-9]{4}|1844[0-5][0-9]{3}|18446[0-6][0-9]{2}|184467[0-3][0-9]|1844674[0-2]|18446743)(\\.[0-9]{1,12}0*)?|18446744(\\.(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,5}|1[0-7][0-9]{5}|18[0-3][0-9]{4}|184[0-3][0-9]{3}|1844[0-5][0-9]{2}|18446[0-6][0-9]|184467[0-2]|1844673)(\\.[0-9]{1,13}0*)?|1844674(\\.([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,4}|1[0-7][0-9]{4}|18[0-3][0-9]{3}|184[0-3][0-9]{2}|1844[0-5][0-9]|18446[0-5]|184466)(\\.[0-9]{1,14}0*)?|184467(\\.([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,3}|1[0-7][0-9]{3}|18[0-3][0-9]{2}|184[0-3][0-9]|1844[0-4]|18445)(\\.[0-9]{1,15}0*)?|18446(\\.([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,2}|1[0-7][0-9]{2}|18[0-3][0-9]|184[0-2]|1843)(\\.[0-9]{1,16}0*)?|1844(\\.([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9][0-9]{0,1}|1[0-7][0-9]|18[0-2]|183)(\\.[0-9]{1,17}0*)?|184(\\.([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-9]|1[0-6]|17)(\\.[0-9]{1,18}0*)?|18(\\.([0-3][0-9]{0,17}|4([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.[0-9]{1,19}0*)?|1(\\.([0-7][0-9]{0,18}|8([0-3][0-9]{0,17}|4([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0[0-9]{0,19}|1([0-7][0-9]{0,18}|8([0-3][0-9]{0,17}|4([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20)$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 18446744073709551615,
+            "minimum": 0
+        },
+        "sint32_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,8}|1[0-9]{9}|20[0-9]{8}|21[0-3][0-9]{7}|214[0-6][0-9]{6}|2147[0-3][0-9]{5}|21474[0-7][0-9]{4}|214748[0-2][0-9]{3}|2147483[0-5][0-9]{2}|21474836[0-3][0-9]|214748364[0-6]|2147483647)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|1[0-9]{8}|20[0-9]{7}|21[0-3][0-9]{6}|214[0-6][0-9]{5}|2147[0-3][0-9]{4}|21474[0-7][0-9]{3}|214748[0-2][0-9]{2}|2147483[0-5][0-9]|21474836[0-2]|214748363)(\\.[0-9]{1,1}0*)?|214748364(\\.([0-6]|7)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|1[0-9]{7}|20[0-9]{6}|21[0-3][0-9]{5}|214[0-6][0-9]{4}|2147[0-3][0-9]{3}|21474[0-7][0-9]{2}|214748[0-2][0-9]|2147483[0-4]|21474835)(\\.[0-9]{1,2}0*)?|21474836(\\.([0-3][0-9]{0,1}|4([0-6]|7)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|1[0-9]{6}|20[0-9]{5}|21[0-3][0-9]{4}|214[0-6][0-9]{3}|2147[0-3][0-9]{2}|21474[0-7][0-9]|214748[0-1]|2147482)(\\.[0-9]{1,3}0*)?|2147483(\\.([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|1[0-9]{5}|20[0-9]{4}|21[0-3][0-9]{3}|214[0-6][0-9]{2}|2147[0-3][0-9]|21474[0-6]|214747)(\\.[0-9]{1,4}0*)?|214748(\\.([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|1[0-9]{4}|20[0-9]{3}|21[0-3][0-9]{2}|214[0-6][0-9]|2147[0-2]|21473)(\\.[0-9]{1,5}0*)?|21474(\\.([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|1[0-9]{3}|20[0-9]{2}|21[0-3][0-9]|214[0-5]|2146)(\\.[0-9]{1,6}0*)?|2147(\\.([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|1[0-9]{2}|20[0-9]|21[0-2]|213)(\\.[0-9]{1,7}0*)?|214(\\.([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|1[0-9]|20)(\\.[0-9]{1,8}0*)?|21(\\.([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|1)(\\.[0-9]{1,9}0*)?|2(\\.(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20|-(([1-9][0-9]{0,8}|1[0-9]{9}|20[0-9]{8}|21[0-3][0-9]{7}|214[0-6][0-9]{6}|2147[0-3][0-9]{5}|21474[0-7][0-9]{4}|214748[0-2][0-9]{3}|2147483[0-5][0-9]{2}|21474836[0-3][0-9]|214748364[0-7]|2147483648)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|1[0-9]{8}|20[0-9]{7}|21[0-3][0-9]{6}|214[0-6][0-9]{5}|2147[0-3][0-9]{4}|21474[0-7][0-9]{3}|214748[0-2][0-9]{2}|2147483[0-5][0-9]|21474836[0-2]|214748363)(\\.[0-9]{1,1}0*)?|214748364(\\.([0-7]|8)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|1[0-9]{7}|20[0-9]{6}|21[0-3][0-9]{5}|214[0-6][0-9]{4}|2147[0-3][0-9]{3}|21474[0-7][0-9]{2}|214748[0-2][0-9]|2147483[0-4]|21474835)(\\.[0-9]{1,2}0*)?|21474836(\\.([0-3][0-9]{0,1}|4([0-7]|8)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|1[0-9]{6}|20[0-9]{5}|21[0-3][0-9]{4}|214[0-6][0-9]{3}|2147[0-3][0-9]{2}|21474[0-7][0-9]|214748[0-1]|2147482)(\\.[0-9]{1,3}0*)?|2147483(\\.([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|1[0-9]{5}|20[0-9]{4}|21[0-3][0-9]{3}|214[0-6][0-9]{2}|2147[0-3][0-9]|21474[0-6]|214747)(\\.[0-9]{1,4}0*)?|214748(\\.([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|1[0-9]{4}|20[0-9]{3}|21[0-3][0-9]{2}|214[0-6][0-9]|2147[0-2]|21473)(\\.[0-9]{1,5}0*)?|21474(\\.([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|1[0-9]{3}|20[0-9]{2}|21[0-3][0-9]|214[0-5]|2146)(\\.[0-9]{1,6}0*)?|2147(\\.([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|1[0-9]{2}|20[0-9]|21[0-2]|213)(\\.[0-9]{1,7}0*)?|214(\\.([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|1[0-9]|20)(\\.[0-9]{1,8}0*)?|21(\\.([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|1)(\\.[0-9]{1,9}0*)?|2(\\.(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20))$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "sint64_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,17}|[1-8][0-9]{18}|9[0-1][0-9]{17}|92[0-1][0-9]{16}|922[0-2][0-9]{15}|9223[0-2][0-9]{14}|92233[0-6][0-9]{13}|922337[0-1][0-9]{12}|92233720[0-2][0-9]{10}|922337203[0-5][0-9]{9}|9223372036[0-7][0-9]{8}|92233720368[0-4][0-9]{7}|922337203685[0-3][0-9]{6}|9223372036854[0-6][0-9]{5}|92233720368547[0-6][0-9]{4}|922337203685477[0-4][0-9]{3}|9223372036854775[0-7][0-9]{2}|922337203685477580[0-6]|9223372036854775807)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,16}|[1-8][0-9]{17}|9[0-1][0-9]{16}|92[0-1][0-9]{15}|922[0-2][0-9]{14}|9223[0-2][0-9]{13}|92233[0-6][0-9]{12}|922337[0-1][0-9]{11}|92233720[0-2][0-9]{9}|922337203[0-5][0-9]{8}|9223372036[0-7][0-9]{7}|92233720368[0-4][0-9]{6}|922337203685[0-3][0-9]{5}|9223372036854[0-6][0-9]{4}|92233720368547[0-6][0-9]{3}|922337203685477[0-4][0-9]{2}|9223372036854775[0-6][0-9]|92233720368547757[0-8]|922337203685477579)(\\.[0-9]{1,1}0*)?|922337203685477580(\\.([0-6]|7)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,15}|[1-8][0-9]{16}|9[0-1][0-9]{15}|92[0-1][0-9]{14}|922[0-2][0-9]{13}|9223[0-2][0-9]{12}|92233[0-6][0-9]{11}|922337[0-1][0-9]{10}|92233720[0-2][0-9]{8}|922337203[0-5][0-9]{7}|9223372036[0-7][0-9]{6}|92233720368[0-4][0-9]{5}|922337203685[0-3][0-9]{4}|9223372036854[0-6][0-9]{3}|92233720368547[0-6][0-9]{2}|922337203685477[0-4][0-9]|9223372036854775[0-6]|92233720368547757)(\\.[0-9]{1,2}0*)?|92233720368547758(\\.(0([0-6]|7)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,14}|[1-8][0-9]{15}|9[0-1][0-9]{14}|92[0-1][0-9]{13}|922[0-2][0-9]{12}|9223[0-2][0-9]{11}|92233[0-6][0-9]{10}|922337[0-1][0-9]{9}|92233720[0-2][0-9]{7}|922337203[0-5][0-9]{6}|9223372036[0-7][0-9]{5}|92233720368[0-4][0-9]{4}|922337203685[0-3][0-9]{3}|9223372036854[0-6][0-9]{2}|92233720368547[0-6][0-9]|922337203685477[0-3]|9223372036854774)(\\.[0-9]{1,3}0*)?|9223372036854775(\\.([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,13}|[1-8][0-9]{14}|9[0-1][0-9]{13}|92[0-1][0-9]{12}|922[0-2][0-9]{11}|9223[0-2][0-9]{10}|92233[0-6][0-9]{9}|922337[0-1][0-9]{8}|92233720[0-2][0-9]{6}|922337203[0-5][0-9]{5}|9223372036[0-7][0-9]{4}|92233720368[0-4][0-9]{3}|922337203685[0-3][0-9]{2}|9223372036854[0-6][0-9]|92233720368547[0-5]|922337203685476)(\\.[0-9]{1,4}0*)?|922337203685477(\\.([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,12}|[1-8][0-9]{13}|9[0-1][0-9]{12}|92[0-1][0-9]{11}|922[0-2][0-9]{10}|9223[0-2][0-9]{9}|92233[0-6][0-9]{8}|922337[0-1][0-9]{7}|92233720[0-2][0-9]{5}|922337203[0-5][0-9]{4}|9223372036[0-7][0-9]{3}|92233720368[0-4][0-9]{2}|922337203685[0-3][0-9]|9223372036854[0-5]|92233720368546)(\\.[0-9]{1,5}0*)?|92233720368547(\\.([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,11}|[1-8][0-9]{12}|9[0-1][0-9]{11}|92[0-1][0-9]{10}|922[0-2][0-9]{9}|9223[0-2][0-9]{8}|92233[0-6][0-9]{7}|922337[0-1][0-9]{6}|92233720[0-2][0-9]{4}|922337203[0-5][0-9]{3}|9223372036[0-7][0-9]{2}|92233720368[0-4][0-9]|922337203685[0-2]|9223372036853)(\\.[0-9]{1,6}0*)?|9223372036854(\\.([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,10}|[1-8][0-9]{11}|9[0-1][0-9]{10}|92[0-1][0-9]{9}|922[0-2][0-9]{8}|9223[0-2][0-9]{7}|92233[0-6][0-9]{6}|922337[0-1][0-9]{5}|92233720[0-2][0-9]{3}|922337203[0-5][0-9]{2}|9223372036[0-7][0-9]|92233720368[0-3]|922337203684)(\\.[0-9]{1,7}0*)?|922337203685(\\.([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,9}|[1-8][0-9]{10}|9[0-1][0-9]{9}|92[0-1][0-9]{8}|922[0-2][0-9]{7}|9223[0-2][0-9]{6}|92233[0-6][0-9]{5}|922337[0-1][0-9]{4}|92233720[0-2][0-9]{2}|922337203[0-5][0-9]|9223372036[0-6]|92233720367)(\\.[0-9]{1,8}0*)?|92233720368(\\.([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,8}|[1-8][0-9]{9}|9[0-1][0-9]{8}|92[0-1][0-9]{7}|922[0-2][0-9]{6}|9223[0-2][0-9]{5}|92233[0-6][0-9]{4}|922337[0-1][0-9]{3}|92233720[0-2][0-9]|922337203[0-4]|9223372035)(\\.[0-9]{1,9}0*)?|9223372036(\\.([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,7}|[1-8][0-9]{8}|9[0-1][0-9]{7}|92[0-1][0-9]{6}|922[0-2][0-9]{5}|9223[0-2][0-9]{4}|92233[0-6][0-9]{3}|922337[0-1][0-9]{2}|92233720[0-1]|922337202)(\\.[0-9]{1,10}0*)?|922337203(\\.([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,6}|[1-8][0-9]{7}|9[0-1][0-9]{6}|92[0-1][0-9]{5}|922[0-2][0-9]{4}|9223[0-2][0-9]{3}|92233[0-6][0-9]{2}|9223370[0-9]|9223371[0-8]|92233719)(\\.[0-9]{1,11}0*)?|92233720(\\.([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,5}|[1-8][0-9]{6}|9[0-1][0-9]{5}|92[0-1][0-9]{4}|922[0-2][0-9]{3}|9223[0-2][0-9]{2}|92233[0-6][0-9]|9223370|9223371)(\\.[0-9]{1,12}0*)?|9223372(\\.(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,4}|[1-8][0-9]{5}|9[0-1][0-9]{4}|92[0-1][0-9]{3}|922[0-2][0-9]{2}|9223[0-2][0-9]|92233[0-5]|922336)(\\.[0-9]{1,13}0*)?|922337(\\.([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,3}|[1-8][0-9]{4}|9[0-1][0-9]{3}|92[0-1][0-9]{2}|922[0-2][0-9]|9223[0-1]|92232)(\\.[0-9]{1,14}0*)?|92233(\\.([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,2}|[1-8][0-9]{3}|9[0-1][0-9]{2}|92[0-1][0-9]|922[0-1]|9222)(\\.[0-9]{1,15}0*)?|9223(\\.([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,1}|[1-8][0-9]{2}|9[0-1][0-9]|920|921)(\\.[0-9]{1,16}0*)?|922(\\.([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9]|[1-8][0-9]|90|91)(\\.[0-9]{1,17}0*)?|92(\\.([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-7]|8)(\\.[0-9]{1,18}0*)?|9(\\.([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20|-(([1-9][0-9]{0,17}|[1-8][0-9]{18}|9[0-1][0-9]{17}|92[0-1][0-9]{16}|922[0-2][0-9]{15}|9223[0-2][0-9]{14}|92233[0-6][0-9]{13}|922337[0-1][0-9]{12}|92233720[0-2][0-9]{10}|922337203[0-5][0-9]{9}|9223372036[0-7][0-9]{8}|92233720368[0-4][0-9]{7}|922337203685[0-3][0-9]{6}|9223372036854[0-6][0-9]{5}|92233720368547[0-6][0-9]{4}|922337203685477[0-4][0-9]{3}|9223372036854775[0-7][0-9]{2}|922337203685477580[0-7]|9223372036854775808)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,16}|[1-8][0-9]{17}|9[0-1][0-9]{16}|92[0-1][0-9]{15}|922[0-2][0-9]{14}|9223[0-2][0-9]{13}|92233[0-6][0-9]{12}|922337[0-1][0-9]{11}|92233720[0-2][0-9]{9}|922337203[0-5][0-9]{8}|9223372036[0-7][0-9]{7}|92233720368[0-4][0-9]{6}|922337203685[0-3][0-9]{5}|9223372036854[0-6][0-9]{4}|92233720368547[0-6][0-9]{3}|922337203685477[0-4][0-9]{2}|9223372036854775[0-6][0-9]|92233720368547757[0-8]|922337203685477579)(\\.[0-9]{1,1}0*)?|922337203685477580(\\.([0-7]|8)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,15}|[1-8][0-9]{16}|9[0-1][0-9]{15}|92[0-1][0-9]{14}|922[0-2][0-9]{13}|9223[0-2][0-9]{12}|92233[0-6][0-9]{11}|922337[0-1][0-9]{10}|92233720[0-2][0-9]{8}|922337203[0-5][0-9]{7}|9223372036[0-7][0-9]{6}|92233720368[0-4][0-9]{5}|922337203685[0-3][0-9]{4}|9223372036854[0-6][0-9]{3}|92233720368547[0-6][0-9]{2}|922337203685477[0-4][0-9]|9223372036854775[0-6]|92233720368547757)(\\.[0-9]{1,2}0*)?|92233720368547758(\\.(0([0-7]|8)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,14}|[1-8][0-9]{15}|9[0-1][0-9]{14}|92[0-1][0-9]{13}|922[0-2][0-9]{12}|9223[0-2][0-9]{11}|92233[0-6][0-9]{10}|922337[0-1][0-9]{9}|92233720[0-2][0-9]{7}|922337203[0-5][0-9]{6}|9223372036[0-7][0-9]{5}|92233720368[0-4][0-9]{4}|922337203685[0-3][0-9]{3}|9223372036854[0-6][0-9]{2}|92233720368547[0-6][0-9]|922337203685477[0-3]|9223372036854774)(\\.[0-9]{1,3}0*)?|9223372036854775(\\.([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,13}|[1-8][0-9]{14}|9[0-1][0-9]{13}|92[0-1][0-9]{12}|922[0-2][0-9]{11}|9223[0-2][0-9]{10}|92233[0-6][0-9]{9}|922337[0-1][0-9]{8}|92233720[0-2][0-9]{6}|922337203[0-5][0-9]{5}|9223372036[0-7][0-9]{4}|92233720368[0-4][0-9]{3}|922337203685[0-3][0-9]{2}|9223372036854[0-6][0-9]|92233720368547[0-5]|922337203685476)(\\.[0-9]{1,4}0*)?|922337203685477(\\.([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,12}|[1-8][0-9]{13}|9[0-1][0-9]{12}|92[0-1][0-9]{11}|922[0-2][0-9]{10}|9223[0-2][0-9]{9}|92233[0-6][0-9]{8}|922337[0-1][0-9]{7}|92233720[0-2][0-9]{5}|922337203[0-5][0-9]{4}|9223372036[0-7][0-9]{3}|92233720368[0-4][0-9]{2}|922337203685[0-3][0-9]|9223372036854[0-5]|92233720368546)(\\.[0-9]{1,5}0*)?|92233720368547(\\.([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,11}|[1-8][0-9]{12}|9[0-1][0-9]{11}|92[0-1][0-9]{10}|922[0-2][0-9]{9}|9223[0-2][0-9]{8}|92233[0-6][0-9]{7}|922337[0-1][0-9]{6}|92233720[0-2][0-9]{4}|922337203[0-5][0-9]{3}|9223372036[0-7][0-9]{2}|92233720368[0-4][0-9]|922337203685[0-2]|9223372036853)(\\.[0-9]{1,6}0*)?|9223372036854(\\.([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,10}|[1-8][0-9]{11}|9[0-1][0-9]{10}|92[0-1][0-9]{9}|922[0-2][0-9]{8}|9223[0-2][0-9]{7}|92233[0-6][0-9]{6}|922337[0-1][0-9]{5}|92233720[0-2][0-9]{3}|922337203[0-5][0-9]{2}|9223372036[0-7][0-9]|92233720368[0-3]|922337203684)(\\.[0-9]{1,7}0*)?|922337203685(\\.([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,9}|[1-8][0-9]{10}|9[0-1][0-9]{9}|92[0-1][0-9]{8}|922[0-2][0-9]{7}|9223[0-2][0-9]{6}|92233[0-6][0-9]{5}|922337[0-1][0-9]{4}|92233720[0-2][0-9]{2}|922337203[0-5][0-9]|9223372036[0-6]|92233720367)(\\.[0-9]{1,8}0*)?|92233720368(\\.([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,8}|[1-8][0-9]{9}|9[0-1][0-9]{8}|92[0-1][0-9]{7}|922[0-2][0-9]{6}|9223[0-2][0-9]{5}|92233[0-6][0-9]{4}|922337[0-1][0-9]{3}|92233720[0-2][0-9]|922337203[0-4]|9223372035)(\\.[0-9]{1,9}0*)?|9223372036(\\.([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,7}|[1-8][0-9]{8}|9[0-1][0-9]{7}|92[0-1][0-9]{6}|922[0-2][0-9]{5}|9223[0-2][0-9]{4}|92233[0-6][0-9]{3}|922337[0-1][0-9]{2}|92233720[0-1]|922337202)(\\.[0-9]{1,10}0*)?|922337203(\\.([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,6}|[1-8][0-9]{7}|9[0-1][0-9]{6}|92[0-1][0-9]{5}|922[0-2][0-9]{4}|9223[0-2][0-9]{3}|92233[0-6][0-9]{2}|9223370[0-9]|9223371[0-8]|92233719)(\\.[0-9]{1,11}0*)?|92233720(\\.([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,5}|[1-8][0-9]{6}|9[0-1][0-9]{5}|92[0-1][0-9]{4}|922[0-2][0-9]{3}|9223[0-2][0-9]{2}|92233[0-6][0-9]|9223370|9223371)(\\.[0-9]{1,12}0*)?|9223372(\\.(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,4}|[1-8][0-9]{5}|9[0-1][0-9]{4}|92[0-1][0-9]{3}|922[0-2][0-9]{2}|9223[0-2][0-9]|92233[0-5]|922336)(\\.[0-9]{1,13}0*)?|922337(\\.([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,3}|[1-8][0-9]{4}|9[0-1][0-9]{3}|92[0-1][0-9]{2}|922[0-2][0-9]|9223[0-1]|92232)(\\.[0-9]{1,14}0*)?|92233(\\.([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,2}|[1-8][0-9]{3}|9[0-1][0-9]{2}|92[0-1][0-9]|922[0-1]|9222)(\\.[0-9]{1,15}0*)?|9223(\\.([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,1}|[1-8][0-9]{2}|9[0-1][0-9]|920|921)(\\.[0-9]{1,16}0*)?|922(\\.([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9]|[1-8][0-9]|90|91)(\\.[0-9]{1,17}0*)?|92(\\.([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-7]|8)(\\.[0-9]{1,18}0*)?|9(\\.([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20))$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "fixed32_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,8}|[1-3][0-9]{9}|4[0-1][0-9]{8}|42[0-8][0-9]{7}|429[0-3][0-9]{6}|4294[0-8][0-9]{5}|42949[0-5][0-9]{4}|429496[0-6][0-9]{3}|4294967[0-1][0-9]{2}|42949672[0-8][0-9]|429496729[0-4]|4294967295)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|[1-3][0-9]{8}|4[0-1][0-9]{7}|42[0-8][0-9]{6}|429[0-3][0-9]{5}|4294[0-8][0-9]{4}|42949[0-5][0-9]{3}|429496[0-6][0-9]{2}|4294967[0-1][0-9]|42949672[0-7]|429496728)(\\.[0-9]{1,1}0*)?|429496729(\\.([0-4]|5)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|[1-3][0-9]{7}|4[0-1][0-9]{6}|42[0-8][0-9]{5}|429[0-3][0-9]{4}|4294[0-8][0-9]{3}|42949[0-5][0-9]{2}|429496[0-6][0-9]|42949670|42949671)(\\.[0-9]{1,2}0*)?|42949672(\\.([0-8][0-9]{0,1}|9([0-4]|5)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|[1-3][0-9]{6}|4[0-1][0-9]{5}|42[0-8][0-9]{4}|429[0-3][0-9]{3}|4294[0-8][0-9]{2}|42949[0-5][0-9]|429496[0-5]|4294966)(\\.[0-9]{1,3}0*)?|4294967(\\.([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|[1-3][0-9]{5}|4[0-1][0-9]{4}|42[0-8][0-9]{3}|429[0-3][0-9]{2}|4294[0-8][0-9]|42949[0-4]|429495)(\\.[0-9]{1,4}0*)?|429496(\\.([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|[1-3][0-9]{4}|4[0-1][0-9]{3}|42[0-8][0-9]{2}|429[0-3][0-9]|4294[0-7]|42948)(\\.[0-9]{1,5}0*)?|42949(\\.([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|[1-3][0-9]{3}|4[0-1][0-9]{2}|42[0-8][0-9]|429[0-2]|4293)(\\.[0-9]{1,6}0*)?|4294(\\.([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|[1-3][0-9]{2}|4[0-1][0-9]|42[0-7]|428)(\\.[0-9]{1,7}0*)?|429(\\.([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|[1-3][0-9]|40|41)(\\.[0-9]{1,8}0*)?|42(\\.([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-2]|3)(\\.[0-9]{1,9}0*)?|4(\\.([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-3][0-9]{0,9}|4([0-1][0-9]{0,8}|2([0-8][0-9]{0,7}|9([0-3][0-9]{0,6}|4([0-8][0-9]{0,5}|9([0-5][0-9]{0,4}|6([0-6][0-9]{0,3}|7([0-1][0-9]{0,2}|2([0-8][0-9]{0,1}|9([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20)$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "fixed64_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,18}|1[0-7][0-9]{18}|18[0-3][0-9]{17}|184[0-3][0-9]{16}|1844[0-5][0-9]{15}|18446[0-6][0-9]{14}|184467[0-3][0-9]{13}|1844674[0-3][0-9]{12}|184467440[0-6][0-9]{10}|1844674407[0-2][0-9]{9}|18446744073[0-6][0-9]{8}|1844674407370[0-8][0-9]{6}|18446744073709[0-4][0-9]{5}|184467440737095[0-4][0-9]{4}|18446744073709550[0-9]{3}|18446744073709551[0-5][0-9]{2}|1844674407370955160[0-9]|1844674407370955161[0-4]|18446744073709551615)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,17}|1[0-7][0-9]{17}|18[0-3][0-9]{16}|184[0-3][0-9]{15}|1844[0-5][0-9]{14}|18446[0-6][0-9]{13}|184467[0-3][0-9]{12}|1844674[0-3][0-9]{11}|184467440[0-6][0-9]{9}|1844674407[0-2][0-9]{8}|18446744073[0-6][0-9]{7}|1844674407370[0-8][0-9]{5}|18446744073709[0-4][0-9]{4}|184467440737095[0-4][0-9]{3}|18446744073709550[0-9]{2}|18446744073709551[0-5][0-9]|1844674407370955160)(\\.[0-9]{1,1}0*)?|1844674407370955161(\\.([0-4]|5)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,16}|1[0-7][0-9]{16}|18[0-3][0-9]{15}|184[0-3][0-9]{14}|1844[0-5][0-9]{13}|18446[0-6][0-9]{12}|184467[0-3][0-9]{11}|1844674[0-3][0-9]{10}|184467440[0-6][0-9]{8}|1844674407[0-2][0-9]{7}|18446744073[0-6][0-9]{6}|1844674407370[0-8][0-9]{4}|18446744073709[0-4][0-9]{3}|184467440737095[0-4][0-9]{2}|18446744073709550[0-9]|18446744073709551[0-4]|184467440737095515)(\\.[0-9]{1,2}0*)?|184467440737095516(\\.(0[0-9]{0,1}|1([0-4]|5)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,15}|1[0-7][0-9]{15}|18[0-3][0-9]{14}|184[0-3][0-9]{13}|1844[0-5][0-9]{12}|18446[0-6][0-9]{11}|184467[0-3][0-9]{10}|1844674[0-3][0-9]{9}|184467440[0-6][0-9]{7}|1844674407[0-2][0-9]{6}|18446744073[0-6][0-9]{5}|1844674407370[0-8][0-9]{3}|18446744073709[0-4][0-9]{2}|184467440737095[0-4][0-9]|18446744073709550)(\\.[0-9]{1,3}0*)?|18446744073709551(\\.([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,14}|1[0-7][0-9]{14}|18[0-3][0-9]{13}|184[0-3][0-9]{12}|1844[0-5][0-9]{11}|18446[0-6][0-9]{10}|184467[0-3][0-9]{9}|1844674[0-3][0-9]{8}|184467440[0-6][0-9]{6}|1844674407[0-2][0-9]{5}|18446744073[0-6][0-9]{4}|1844674407370[0-8][0-9]{2}|18446744073709[0-4][0-9]|184467440737095[0-3]|1844674407370954)(\\.[0-9]{1,4}0*)?|1844674407370955(\\.(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,13}|1[0-7][0-9]{13}|18[0-3][0-9]{12}|184[0-3][0-9]{11}|1844[0-5][0-9]{10}|18446[0-6][0-9]{9}|184467[0-3][0-9]{8}|1844674[0-3][0-9]{7}|184467440[0-6][0-9]{5}|1844674407[0-2][0-9]{4}|18446744073[0-6][0-9]{3}|1844674407370[0-8][0-9]|18446744073709[0-3]|184467440737094)(\\.[0-9]{1,5}0*)?|184467440737095(\\.([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,12}|1[0-7][0-9]{12}|18[0-3][0-9]{11}|184[0-3][0-9]{10}|1844[0-5][0-9]{9}|18446[0-6][0-9]{8}|184467[0-3][0-9]{7}|1844674[0-3][0-9]{6}|184467440[0-6][0-9]{4}|1844674407[0-2][0-9]{3}|18446744073[0-6][0-9]{2}|1844674407370[0-7]|18446744073708)(\\.[0-9]{1,6}0*)?|18446744073709(\\.([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,11}|1[0-7][0-9]{11}|18[0-3][0-9]{10}|184[0-3][0-9]{9}|1844[0-5][0-9]{8}|18446[0-6][0-9]{7}|184467[0-3][0-9]{6}|1844674[0-3][0-9]{5}|184467440[0-6][0-9]{3}|1844674407[0-2][0-9]{2}|18446744073[0-5][0-9]|184467440736[0-8]|1844674407369)(\\.[0-9]{1,7}0*)?|1844674407370(\\.([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,10}|1[0-7][0-9]{10}|18[0-3][0-9]{9}|184[0-3][0-9]{8}|1844[0-5][0-9]{7}|18446[0-6][0-9]{6}|184467[0-3][0-9]{5}|1844674[0-3][0-9]{4}|184467440[0-6][0-9]{2}|1844674407[0-2][0-9]|18446744073[0-5]|184467440736)(\\.[0-9]{1,8}0*)?|184467440737(\\.(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,9}|1[0-7][0-9]{9}|18[0-3][0-9]{8}|184[0-3][0-9]{7}|1844[0-5][0-9]{6}|18446[0-6][0-9]{5}|184467[0-3][0-9]{4}|1844674[0-3][0-9]{3}|184467440[0-6][0-9]|1844674407[0-1]|18446744072)(\\.[0-9]{1,9}0*)?|18446744073(\\.([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,8}|1[0-7][0-9]{8}|18[0-3][0-9]{7}|184[0-3][0-9]{6}|1844[0-5][0-9]{5}|18446[0-6][0-9]{4}|184467[0-3][0-9]{3}|1844674[0-3][0-9]{2}|184467440[0-5]|1844674406)(\\.[0-9]{1,10}0*)?|1844674407(\\.([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,7}|1[0-7][0-9]{7}|18[0-3][0-9]{6}|184[0-3][0-9]{5}|1844[0-5][0-9]{4}|18446[0-6][0-9]{3}|184467[0-3][0-9]{2}|1844674[0-2][0-9]|18446743[0-8]|184467439)(\\.[0-9]{1,11}0*)?|184467440(\\.([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,6}|1[0-7][0-9]{6}|18[0-3][0-9]{5}|184[0-3][0-9]{4}|1844[0-5][0-9]{3}|18446[0-6][0-9]{2}|184467[0-3][0-9]|1844674[0-2]|18446743)(\\.[0-9]{1,12}0*)?|18446744(\\.(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,5}|1[0-7][0-9]{5}|18[0-3][0-9]{4}|184[0-3][0-9]{3}|1844[0-5][0-9]{2}|18446[0-6][0-9]|184467[0-2]|1844673)(\\.[0-9]{1,13}0*)?|1844674(\\.([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,4}|1[0-7][0-9]{4}|18[0-3][0-9]{3}|184[0-3][0-9]{2}|1844[0-5][0-9]|18446[0-5]|184466)(\\.[0-9]{1,14}0*)?|184467(\\.([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,3}|1[0-7][0-9]{3}|18[0-3][0-9]{2}|184[0-3][0-9]|1844[0-4]|18445)(\\.[0-9]{1,15}0*)?|18446(\\.([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,2}|1[0-7][0-9]{2}|18[0-3][0-9]|184[0-2]|1843)(\\.[0-9]{1,16}0*)?|1844(\\.([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9][0-9]{0,1}|1[0-7][0-9]|18[0-2]|183)(\\.[0-9]{1,17}0*)?|184(\\.([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-9]|1[0-6]|17)(\\.[0-9]{1,18}0*)?|18(\\.([0-3][0-9]{0,17}|4([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.[0-9]{1,19}0*)?|1(\\.([0-7][0-9]{0,18}|8([0-3][0-9]{0,17}|4([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0[0-9]{0,19}|1([0-7][0-9]{0,18}|8([0-3][0-9]{0,17}|4([0-3][0-9]{0,16}|4([0-5][0-9]{0,15}|6([0-6][0-9]{0,14}|7([0-3][0-9]{0,13}|4([0-3][0-9]{0,12}|4(0([0-6][0-9]{0,10}|7([0-2][0-9]{0,9}|3([0-6][0-9]{0,8}|7(0([0-8][0-9]{0,6}|9([0-4][0-9]{0,5}|5([0-4][0-9]{0,4}|5(0[0-9]{0,3}|1([0-5][0-9]{0,2}|6(0[0-9]{0,1}|1([0-4]|5)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20)$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 18446744073709551615,
+            "minimum": 0
+        },
+        "sfixed32_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,8}|1[0-9]{9}|20[0-9]{8}|21[0-3][0-9]{7}|214[0-6][0-9]{6}|2147[0-3][0-9]{5}|21474[0-7][0-9]{4}|214748[0-2][0-9]{3}|2147483[0-5][0-9]{2}|21474836[0-3][0-9]|214748364[0-6]|2147483647)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|1[0-9]{8}|20[0-9]{7}|21[0-3][0-9]{6}|214[0-6][0-9]{5}|2147[0-3][0-9]{4}|21474[0-7][0-9]{3}|214748[0-2][0-9]{2}|2147483[0-5][0-9]|21474836[0-2]|214748363)(\\.[0-9]{1,1}0*)?|214748364(\\.([0-6]|7)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|1[0-9]{7}|20[0-9]{6}|21[0-3][0-9]{5}|214[0-6][0-9]{4}|2147[0-3][0-9]{3}|21474[0-7][0-9]{2}|214748[0-2][0-9]|2147483[0-4]|21474835)(\\.[0-9]{1,2}0*)?|21474836(\\.([0-3][0-9]{0,1}|4([0-6]|7)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|1[0-9]{6}|20[0-9]{5}|21[0-3][0-9]{4}|214[0-6][0-9]{3}|2147[0-3][0-9]{2}|21474[0-7][0-9]|214748[0-1]|2147482)(\\.[0-9]{1,3}0*)?|2147483(\\.([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|1[0-9]{5}|20[0-9]{4}|21[0-3][0-9]{3}|214[0-6][0-9]{2}|2147[0-3][0-9]|21474[0-6]|214747)(\\.[0-9]{1,4}0*)?|214748(\\.([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|1[0-9]{4}|20[0-9]{3}|21[0-3][0-9]{2}|214[0-6][0-9]|2147[0-2]|21473)(\\.[0-9]{1,5}0*)?|21474(\\.([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|1[0-9]{3}|20[0-9]{2}|21[0-3][0-9]|214[0-5]|2146)(\\.[0-9]{1,6}0*)?|2147(\\.([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|1[0-9]{2}|20[0-9]|21[0-2]|213)(\\.[0-9]{1,7}0*)?|214(\\.([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|1[0-9]|20)(\\.[0-9]{1,8}0*)?|21(\\.([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|1)(\\.[0-9]{1,9}0*)?|2(\\.(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20|-(([1-9][0-9]{0,8}|1[0-9]{9}|20[0-9]{8}|21[0-3][0-9]{7}|214[0-6][0-9]{6}|2147[0-3][0-9]{5}|21474[0-7][0-9]{4}|214748[0-2][0-9]{3}|2147483[0-5][0-9]{2}|21474836[0-3][0-9]|214748364[0-7]|2147483648)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,7}|1[0-9]{8}|20[0-9]{7}|21[0-3][0-9]{6}|214[0-6][0-9]{5}|2147[0-3][0-9]{4}|21474[0-7][0-9]{3}|214748[0-2][0-9]{2}|2147483[0-5][0-9]|21474836[0-2]|214748363)(\\.[0-9]{1,1}0*)?|214748364(\\.([0-7]|8)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,6}|1[0-9]{7}|20[0-9]{6}|21[0-3][0-9]{5}|214[0-6][0-9]{4}|2147[0-3][0-9]{3}|21474[0-7][0-9]{2}|214748[0-2][0-9]|2147483[0-4]|21474835)(\\.[0-9]{1,2}0*)?|21474836(\\.([0-3][0-9]{0,1}|4([0-7]|8)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,5}|1[0-9]{6}|20[0-9]{5}|21[0-3][0-9]{4}|214[0-6][0-9]{3}|2147[0-3][0-9]{2}|21474[0-7][0-9]|214748[0-1]|2147482)(\\.[0-9]{1,3}0*)?|2147483(\\.([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,4}|1[0-9]{5}|20[0-9]{4}|21[0-3][0-9]{3}|214[0-6][0-9]{2}|2147[0-3][0-9]|21474[0-6]|214747)(\\.[0-9]{1,4}0*)?|214748(\\.([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,3}|1[0-9]{4}|20[0-9]{3}|21[0-3][0-9]{2}|214[0-6][0-9]|2147[0-2]|21473)(\\.[0-9]{1,5}0*)?|21474(\\.([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,2}|1[0-9]{3}|20[0-9]{2}|21[0-3][0-9]|214[0-5]|2146)(\\.[0-9]{1,6}0*)?|2147(\\.([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,1}|1[0-9]{2}|20[0-9]|21[0-2]|213)(\\.[0-9]{1,7}0*)?|214(\\.([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9]|1[0-9]|20)(\\.[0-9]{1,8}0*)?|21(\\.([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|1)(\\.[0-9]{1,9}0*)?|2(\\.(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|(0(\\.([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|(0(\\.(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|(0(\\.(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|(0(\\.(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|(0(\\.(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|(0(\\.(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|(0(\\.(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|(0(\\.(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|(0(\\.(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0(0(0(0(0(0(0(0(0(0([0-1][0-9]{0,9}|2(0[0-9]{0,8}|1([0-3][0-9]{0,7}|4([0-6][0-9]{0,6}|7([0-3][0-9]{0,5}|4([0-7][0-9]{0,4}|8([0-2][0-9]{0,3}|3([0-5][0-9]{0,2}|6([0-3][0-9]{0,1}|4([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20))$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "sfixed64_value": {
+            "pattern": "^(-?0(\\.0+)?([eE][+-]?[0-9]+)?|([1-9][0-9]{0,17}|[1-8][0-9]{18}|9[0-1][0-9]{17}|92[0-1][0-9]{16}|922[0-2][0-9]{15}|9223[0-2][0-9]{14}|92233[0-6][0-9]{13}|922337[0-1][0-9]{12}|92233720[0-2][0-9]{10}|922337203[0-5][0-9]{9}|9223372036[0-7][0-9]{8}|92233720368[0-4][0-9]{7}|922337203685[0-3][0-9]{6}|9223372036854[0-6][0-9]{5}|92233720368547[0-6][0-9]{4}|922337203685477[0-4][0-9]{3}|9223372036854775[0-7][0-9]{2}|922337203685477580[0-6]|9223372036854775807)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,16}|[1-8][0-9]{17}|9[0-1][0-9]{16}|92[0-1][0-9]{15}|922[0-2][0-9]{14}|9223[0-2][0-9]{13}|92233[0-6][0-9]{12}|922337[0-1][0-9]{11}|92233720[0-2][0-9]{9}|922337203[0-5][0-9]{8}|9223372036[0-7][0-9]{7}|92233720368[0-4][0-9]{6}|922337203685[0-3][0-9]{5}|9223372036854[0-6][0-9]{4}|92233720368547[0-6][0-9]{3}|922337203685477[0-4][0-9]{2}|9223372036854775[0-6][0-9]|92233720368547757[0-8]|922337203685477579)(\\.[0-9]{1,1}0*)?|922337203685477580(\\.([0-6]|7)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,15}|[1-8][0-9]{16}|9[0-1][0-9]{15}|92[0-1][0-9]{14}|922[0-2][0-9]{13}|9223[0-2][0-9]{12}|92233[0-6][0-9]{11}|922337[0-1][0-9]{10}|92233720[0-2][0-9]{8}|922337203[0-5][0-9]{7}|9223372036[0-7][0-9]{6}|92233720368[0-4][0-9]{5}|922337203685[0-3][0-9]{4}|9223372036854[0-6][0-9]{3}|92233720368547[0-6][0-9]{2}|922337203685477[0-4][0-9]|9223372036854775[0-6]|92233720368547757)(\\.[0-9]{1,2}0*)?|92233720368547758(\\.(0([0-6]|7)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,14}|[1-8][0-9]{15}|9[0-1][0-9]{14}|92[0-1][0-9]{13}|922[0-2][0-9]{12}|9223[0-2][0-9]{11}|92233[0-6][0-9]{10}|922337[0-1][0-9]{9}|92233720[0-2][0-9]{7}|922337203[0-5][0-9]{6}|9223372036[0-7][0-9]{5}|92233720368[0-4][0-9]{4}|922337203685[0-3][0-9]{3}|9223372036854[0-6][0-9]{2}|92233720368547[0-6][0-9]|922337203685477[0-3]|9223372036854774)(\\.[0-9]{1,3}0*)?|9223372036854775(\\.([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,13}|[1-8][0-9]{14}|9[0-1][0-9]{13}|92[0-1][0-9]{12}|922[0-2][0-9]{11}|9223[0-2][0-9]{10}|92233[0-6][0-9]{9}|922337[0-1][0-9]{8}|92233720[0-2][0-9]{6}|922337203[0-5][0-9]{5}|9223372036[0-7][0-9]{4}|92233720368[0-4][0-9]{3}|922337203685[0-3][0-9]{2}|9223372036854[0-6][0-9]|92233720368547[0-5]|922337203685476)(\\.[0-9]{1,4}0*)?|922337203685477(\\.([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,12}|[1-8][0-9]{13}|9[0-1][0-9]{12}|92[0-1][0-9]{11}|922[0-2][0-9]{10}|9223[0-2][0-9]{9}|92233[0-6][0-9]{8}|922337[0-1][0-9]{7}|92233720[0-2][0-9]{5}|922337203[0-5][0-9]{4}|9223372036[0-7][0-9]{3}|92233720368[0-4][0-9]{2}|922337203685[0-3][0-9]|9223372036854[0-5]|92233720368546)(\\.[0-9]{1,5}0*)?|92233720368547(\\.([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,11}|[1-8][0-9]{12}|9[0-1][0-9]{11}|92[0-1][0-9]{10}|922[0-2][0-9]{9}|9223[0-2][0-9]{8}|92233[0-6][0-9]{7}|922337[0-1][0-9]{6}|92233720[0-2][0-9]{4}|922337203[0-5][0-9]{3}|9223372036[0-7][0-9]{2}|92233720368[0-4][0-9]|922337203685[0-2]|9223372036853)(\\.[0-9]{1,6}0*)?|9223372036854(\\.([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,10}|[1-8][0-9]{11}|9[0-1][0-9]{10}|92[0-1][0-9]{9}|922[0-2][0-9]{8}|9223[0-2][0-9]{7}|92233[0-6][0-9]{6}|922337[0-1][0-9]{5}|92233720[0-2][0-9]{3}|922337203[0-5][0-9]{2}|9223372036[0-7][0-9]|92233720368[0-3]|922337203684)(\\.[0-9]{1,7}0*)?|922337203685(\\.([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,9}|[1-8][0-9]{10}|9[0-1][0-9]{9}|92[0-1][0-9]{8}|922[0-2][0-9]{7}|9223[0-2][0-9]{6}|92233[0-6][0-9]{5}|922337[0-1][0-9]{4}|92233720[0-2][0-9]{2}|922337203[0-5][0-9]|9223372036[0-6]|92233720367)(\\.[0-9]{1,8}0*)?|92233720368(\\.([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,8}|[1-8][0-9]{9}|9[0-1][0-9]{8}|92[0-1][0-9]{7}|922[0-2][0-9]{6}|9223[0-2][0-9]{5}|92233[0-6][0-9]{4}|922337[0-1][0-9]{3}|92233720[0-2][0-9]|922337203[0-4]|9223372035)(\\.[0-9]{1,9}0*)?|9223372036(\\.([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,7}|[1-8][0-9]{8}|9[0-1][0-9]{7}|92[0-1][0-9]{6}|922[0-2][0-9]{5}|9223[0-2][0-9]{4}|92233[0-6][0-9]{3}|922337[0-1][0-9]{2}|92233720[0-1]|922337202)(\\.[0-9]{1,10}0*)?|922337203(\\.([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,6}|[1-8][0-9]{7}|9[0-1][0-9]{6}|92[0-1][0-9]{5}|922[0-2][0-9]{4}|9223[0-2][0-9]{3}|92233[0-6][0-9]{2}|9223370[0-9]|9223371[0-8]|92233719)(\\.[0-9]{1,11}0*)?|92233720(\\.([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,5}|[1-8][0-9]{6}|9[0-1][0-9]{5}|92[0-1][0-9]{4}|922[0-2][0-9]{3}|9223[0-2][0-9]{2}|92233[0-6][0-9]|9223370|9223371)(\\.[0-9]{1,12}0*)?|9223372(\\.(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,4}|[1-8][0-9]{5}|9[0-1][0-9]{4}|92[0-1][0-9]{3}|922[0-2][0-9]{2}|9223[0-2][0-9]|92233[0-5]|922336)(\\.[0-9]{1,13}0*)?|922337(\\.([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,3}|[1-8][0-9]{4}|9[0-1][0-9]{3}|92[0-1][0-9]{2}|922[0-2][0-9]|9223[0-1]|92232)(\\.[0-9]{1,14}0*)?|92233(\\.([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,2}|[1-8][0-9]{3}|9[0-1][0-9]{2}|92[0-1][0-9]|922[0-1]|9222)(\\.[0-9]{1,15}0*)?|9223(\\.([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,1}|[1-8][0-9]{2}|9[0-1][0-9]|920|921)(\\.[0-9]{1,16}0*)?|922(\\.([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9]|[1-8][0-9]|90|91)(\\.[0-9]{1,17}0*)?|92(\\.([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-7]|8)(\\.[0-9]{1,18}0*)?|9(\\.([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-6]|7)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20|-(([1-9][0-9]{0,17}|[1-8][0-9]{18}|9[0-1][0-9]{17}|92[0-1][0-9]{16}|922[0-2][0-9]{15}|9223[0-2][0-9]{14}|92233[0-6][0-9]{13}|922337[0-1][0-9]{12}|92233720[0-2][0-9]{10}|922337203[0-5][0-9]{9}|9223372036[0-7][0-9]{8}|92233720368[0-4][0-9]{7}|922337203685[0-3][0-9]{6}|9223372036854[0-6][0-9]{5}|92233720368547[0-6][0-9]{4}|922337203685477[0-4][0-9]{3}|9223372036854775[0-7][0-9]{2}|922337203685477580[0-7]|9223372036854775808)((\\.0+)?([eE][+-]?0+)?|0{1}(\\.0+)?[eE]-0*1|0{2}(\\.0+)?[eE]-0*2|0{3}(\\.0+)?[eE]-0*3|0{4}(\\.0+)?[eE]-0*4|0{5}(\\.0+)?[eE]-0*5|0{6}(\\.0+)?[eE]-0*6|0{7}(\\.0+)?[eE]-0*7|0{8}(\\.0+)?[eE]-0*8|0{9}(\\.0+)?[eE]-0*9|0{10}(\\.0+)?[eE]-0*10|0{11}(\\.0+)?[eE]-0*11|0{12}(\\.0+)?[eE]-0*12|0{13}(\\.0+)?[eE]-0*13|0{14}(\\.0+)?[eE]-0*14|0{15}(\\.0+)?[eE]-0*15|0{16}(\\.0+)?[eE]-0*16|0{17}(\\.0+)?[eE]-0*17|0{18}(\\.0+)?[eE]-0*18|0{19}(\\.0+)?[eE]-0*19|0{20}(\\.0+)?[eE]-0*20)|((0|[1-9][0-9]{0,16}|[1-8][0-9]{17}|9[0-1][0-9]{16}|92[0-1][0-9]{15}|922[0-2][0-9]{14}|9223[0-2][0-9]{13}|92233[0-6][0-9]{12}|922337[0-1][0-9]{11}|92233720[0-2][0-9]{9}|922337203[0-5][0-9]{8}|9223372036[0-7][0-9]{7}|92233720368[0-4][0-9]{6}|922337203685[0-3][0-9]{5}|9223372036854[0-6][0-9]{4}|92233720368547[0-6][0-9]{3}|922337203685477[0-4][0-9]{2}|9223372036854775[0-6][0-9]|92233720368547757[0-8]|922337203685477579)(\\.[0-9]{1,1}0*)?|922337203685477580(\\.([0-7]|8)0*)?)[eE]\\+?0*1|((0|[1-9][0-9]{0,15}|[1-8][0-9]{16}|9[0-1][0-9]{15}|92[0-1][0-9]{14}|922[0-2][0-9]{13}|9223[0-2][0-9]{12}|92233[0-6][0-9]{11}|922337[0-1][0-9]{10}|92233720[0-2][0-9]{8}|922337203[0-5][0-9]{7}|9223372036[0-7][0-9]{6}|92233720368[0-4][0-9]{5}|922337203685[0-3][0-9]{4}|9223372036854[0-6][0-9]{3}|92233720368547[0-6][0-9]{2}|922337203685477[0-4][0-9]|9223372036854775[0-6]|92233720368547757)(\\.[0-9]{1,2}0*)?|92233720368547758(\\.(0([0-7]|8)?)0*)?)[eE]\\+?0*2|((0|[1-9][0-9]{0,14}|[1-8][0-9]{15}|9[0-1][0-9]{14}|92[0-1][0-9]{13}|922[0-2][0-9]{12}|9223[0-2][0-9]{11}|92233[0-6][0-9]{10}|922337[0-1][0-9]{9}|92233720[0-2][0-9]{7}|922337203[0-5][0-9]{6}|9223372036[0-7][0-9]{5}|92233720368[0-4][0-9]{4}|922337203685[0-3][0-9]{3}|9223372036854[0-6][0-9]{2}|92233720368547[0-6][0-9]|922337203685477[0-3]|9223372036854774)(\\.[0-9]{1,3}0*)?|9223372036854775(\\.([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)0*)?)[eE]\\+?0*3|((0|[1-9][0-9]{0,13}|[1-8][0-9]{14}|9[0-1][0-9]{13}|92[0-1][0-9]{12}|922[0-2][0-9]{11}|9223[0-2][0-9]{10}|92233[0-6][0-9]{9}|922337[0-1][0-9]{8}|92233720[0-2][0-9]{6}|922337203[0-5][0-9]{5}|9223372036[0-7][0-9]{4}|92233720368[0-4][0-9]{3}|922337203685[0-3][0-9]{2}|9223372036854[0-6][0-9]|92233720368547[0-5]|922337203685476)(\\.[0-9]{1,4}0*)?|922337203685477(\\.([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)0*)?)[eE]\\+?0*4|((0|[1-9][0-9]{0,12}|[1-8][0-9]{13}|9[0-1][0-9]{12}|92[0-1][0-9]{11}|922[0-2][0-9]{10}|9223[0-2][0-9]{9}|92233[0-6][0-9]{8}|922337[0-1][0-9]{7}|92233720[0-2][0-9]{5}|922337203[0-5][0-9]{4}|9223372036[0-7][0-9]{3}|92233720368[0-4][0-9]{2}|922337203685[0-3][0-9]|9223372036854[0-5]|92233720368546)(\\.[0-9]{1,5}0*)?|92233720368547(\\.([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)0*)?)[eE]\\+?0*5|((0|[1-9][0-9]{0,11}|[1-8][0-9]{12}|9[0-1][0-9]{11}|92[0-1][0-9]{10}|922[0-2][0-9]{9}|9223[0-2][0-9]{8}|92233[0-6][0-9]{7}|922337[0-1][0-9]{6}|92233720[0-2][0-9]{4}|922337203[0-5][0-9]{3}|9223372036[0-7][0-9]{2}|92233720368[0-4][0-9]|922337203685[0-2]|9223372036853)(\\.[0-9]{1,6}0*)?|9223372036854(\\.([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)0*)?)[eE]\\+?0*6|((0|[1-9][0-9]{0,10}|[1-8][0-9]{11}|9[0-1][0-9]{10}|92[0-1][0-9]{9}|922[0-2][0-9]{8}|9223[0-2][0-9]{7}|92233[0-6][0-9]{6}|922337[0-1][0-9]{5}|92233720[0-2][0-9]{3}|922337203[0-5][0-9]{2}|9223372036[0-7][0-9]|92233720368[0-3]|922337203684)(\\.[0-9]{1,7}0*)?|922337203685(\\.([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)0*)?)[eE]\\+?0*7|((0|[1-9][0-9]{0,9}|[1-8][0-9]{10}|9[0-1][0-9]{9}|92[0-1][0-9]{8}|922[0-2][0-9]{7}|9223[0-2][0-9]{6}|92233[0-6][0-9]{5}|922337[0-1][0-9]{4}|92233720[0-2][0-9]{2}|922337203[0-5][0-9]|9223372036[0-6]|92233720367)(\\.[0-9]{1,8}0*)?|92233720368(\\.([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*8|((0|[1-9][0-9]{0,8}|[1-8][0-9]{9}|9[0-1][0-9]{8}|92[0-1][0-9]{7}|922[0-2][0-9]{6}|9223[0-2][0-9]{5}|92233[0-6][0-9]{4}|922337[0-1][0-9]{3}|92233720[0-2][0-9]|922337203[0-4]|9223372035)(\\.[0-9]{1,9}0*)?|9223372036(\\.([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*9|((0|[1-9][0-9]{0,7}|[1-8][0-9]{8}|9[0-1][0-9]{7}|92[0-1][0-9]{6}|922[0-2][0-9]{5}|9223[0-2][0-9]{4}|92233[0-6][0-9]{3}|922337[0-1][0-9]{2}|92233720[0-1]|922337202)(\\.[0-9]{1,10}0*)?|922337203(\\.([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*10|((0|[1-9][0-9]{0,6}|[1-8][0-9]{7}|9[0-1][0-9]{6}|92[0-1][0-9]{5}|922[0-2][0-9]{4}|9223[0-2][0-9]{3}|92233[0-6][0-9]{2}|9223370[0-9]|9223371[0-8]|92233719)(\\.[0-9]{1,11}0*)?|92233720(\\.([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*11|((0|[1-9][0-9]{0,5}|[1-8][0-9]{6}|9[0-1][0-9]{5}|92[0-1][0-9]{4}|922[0-2][0-9]{3}|9223[0-2][0-9]{2}|92233[0-6][0-9]|9223370|9223371)(\\.[0-9]{1,12}0*)?|9223372(\\.(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*12|((0|[1-9][0-9]{0,4}|[1-8][0-9]{5}|9[0-1][0-9]{4}|92[0-1][0-9]{3}|922[0-2][0-9]{2}|9223[0-2][0-9]|92233[0-5]|922336)(\\.[0-9]{1,13}0*)?|922337(\\.([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*13|((0|[1-9][0-9]{0,3}|[1-8][0-9]{4}|9[0-1][0-9]{3}|92[0-1][0-9]{2}|922[0-2][0-9]|9223[0-1]|92232)(\\.[0-9]{1,14}0*)?|92233(\\.([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*14|((0|[1-9][0-9]{0,2}|[1-8][0-9]{3}|9[0-1][0-9]{2}|92[0-1][0-9]|922[0-1]|9222)(\\.[0-9]{1,15}0*)?|9223(\\.([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*15|((0|[1-9][0-9]{0,1}|[1-8][0-9]{2}|9[0-1][0-9]|920|921)(\\.[0-9]{1,16}0*)?|922(\\.([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*16|((0|[1-9]|[1-8][0-9]|90|91)(\\.[0-9]{1,17}0*)?|92(\\.([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*17|((0|[1-7]|8)(\\.[0-9]{1,18}0*)?|9(\\.([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*18|(0(\\.([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*19|(0(\\.(0([0-8][0-9]{0,18}|9([0-1][0-9]{0,17}|2([0-1][0-9]{0,16}|2([0-2][0-9]{0,15}|3([0-2][0-9]{0,14}|3([0-6][0-9]{0,13}|7([0-1][0-9]{0,12}|2(0([0-2][0-9]{0,10}|3([0-5][0-9]{0,9}|6([0-7][0-9]{0,8}|8([0-4][0-9]{0,7}|5([0-3][0-9]{0,6}|4([0-6][0-9]{0,5}|7([0-6][0-9]{0,4}|7([0-4][0-9]{0,3}|5([0-7][0-9]{0,2}|8(0([0-7]|8)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)?)0*)?)[eE]\\+?0*20))$",
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "bool_value": {
+            "type": "boolean"
+        },
+        "string_value": {
+            "type": "string"
+        },
+        "bytes_value": {
+            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+            "type": "string",
+            "contentEncoding": "base64"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "One field of every scalar type:"
+}`
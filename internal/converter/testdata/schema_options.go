@@ -0,0 +1,190 @@
+package testdata
+
+const SchemaOptions = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "name"
+    ],
+    "properties": {
+        "name": {
+            "type": "string",
+            "title": "Name",
+            "description": "What to call it",
+            "examples": [
+                "Ada",
+                "Grace"
+            ]
+        },
+        "id": {
+            "type": "string",
+            "format": "uuid",
+            "readOnly": true
+        },
+        "settings": {
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object"
+        },
+        "audit": {
+            "required": [
+                "created_by",
+                "updated_by"
+            ],
+            "properties": {
+                "created_by": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object",
+            "readOnly": true
+        },
+        "colour": {
+            "enum": [
+                "RED",
+                0,
+                "GREEN",
+                1,
+                "BLUE",
+                2
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        },
+        "contacts": {
+            "items": {
+                "type": "string",
+                "format": "email"
+            },
+            "type": "array",
+            "description": "The format of a repeated field describes its items:"
+        }
+    },
+    "additionalProperties": false,
+    "type": "object",
+    "title": "Schema options",
+    "description": "A message whose schema is tuned by its options",
+    "examples": [
+        {
+            "colour": "RED",
+            "name": "Ada"
+        }
+    ]
+}`
+
+const SchemaOptionsRefs = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "name"
+    ],
+    "properties": {
+        "name": {
+            "type": "string",
+            "title": "Name",
+            "description": "What to call it",
+            "examples": [
+                "Ada",
+                "Grace"
+            ]
+        },
+        "id": {
+            "type": "string",
+            "format": "uuid",
+            "readOnly": true
+        },
+        "settings": {
+            "$ref": "#/definitions/samples.SchemaOptions.Settings"
+        },
+        "audit": {
+            "$ref": "#/definitions/samples.SchemaOptions.Audit"
+        },
+        "colour": {
+            "enum": [
+                "RED",
+                0,
+                "GREEN",
+                1,
+                "BLUE",
+                2
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        },
+        "contacts": {
+            "items": {
+                "type": "string",
+                "format": "email"
+            },
+            "type": "array",
+            "description": "The format of a repeated field describes its items:"
+        }
+    },
+    "additionalProperties": false,
+    "type": "object",
+    "definitions": {
+        "samples.SchemaOptions.Audit": {
+            "required": [
+                "created_by",
+                "updated_by"
+            ],
+            "properties": {
+                "created_by": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object",
+            "readOnly": true
+        },
+        "samples.SchemaOptions.Settings": {
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            },
+            "additionalProperties": false,
+            "type": "object"
+        }
+    },
+    "title": "Schema options",
+    "description": "A message whose schema is tuned by its options",
+    "examples": [
+        {
+            "colour": "RED",
+            "name": "Ada"
+        }
+    ]
+}`
+
+const SchemaOptionsSettings = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "enabled": {
+            "type": "boolean"
+        }
+    },
+    "additionalProperties": false,
+    "type": "object"
+}`
@@ -10,7 +10,9 @@ const SecondMessage = `{
             "type": "string"
         },
         "id2": {
-            "type": "integer"
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
         },
         "rating2": {
             "type": "number"
@@ -0,0 +1,216 @@
+package testdata
+
+const UploadResult = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "stored": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        }
+    },
+    "additionalProperties": true,
+    "type": "object"
+}`
+
+const WatchRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "topic": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "Which payloads to watch:"
+}`
+
+const PayloadServiceStoreRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "timestamp": {
+            "type": "string"
+        },
+        "id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "rating": {
+            "type": "number"
+        },
+        "complete": {
+            "type": "boolean"
+        },
+        "topology": {
+            "enum": [
+                "FLAT",
+                0,
+                "NESTED_OBJECT",
+                1,
+                "NESTED_MESSAGE",
+                2,
+                "ARRAY_OF_TYPE",
+                3,
+                "ARRAY_OF_OBJECT",
+                4,
+                "ARRAY_OF_MESSAGE",
+                5
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "PayloadService.Store request",
+    "description": "Stores a payload:"
+}`
+
+const PayloadServiceStoreResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "additionalProperties": false,
+    "type": "object",
+    "title": "PayloadService.Store response",
+    "description": "Stores a payload:"
+}`
+
+const PayloadServiceUploadRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "timestamp": {
+            "type": "string"
+        },
+        "id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "rating": {
+            "type": "number"
+        },
+        "complete": {
+            "type": "boolean"
+        },
+        "topology": {
+            "enum": [
+                "FLAT",
+                0,
+                "NESTED_OBJECT",
+                1,
+                "NESTED_MESSAGE",
+                2,
+                "ARRAY_OF_TYPE",
+                3,
+                "ARRAY_OF_OBJECT",
+                4,
+                "ARRAY_OF_MESSAGE",
+                5
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "PayloadService.Upload request",
+    "description": "Stores payloads as they arrive:",
+    "x-streaming": true
+}`
+
+const PayloadServiceUploadResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "stored": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "PayloadService.Upload response",
+    "description": "Stores payloads as they arrive:"
+}`
+
+const PayloadServiceWatchRequest = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "topic": {
+            "type": "string"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "PayloadService.Watch request",
+    "description": "Which payloads to watch:"
+}`
+
+const PayloadServiceWatchResponse = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "timestamp": {
+            "type": "string"
+        },
+        "id": {
+            "type": "integer",
+            "maximum": 2147483647,
+            "minimum": -2147483648
+        },
+        "rating": {
+            "type": "number"
+        },
+        "complete": {
+            "type": "boolean"
+        },
+        "topology": {
+            "enum": [
+                "FLAT",
+                0,
+                "NESTED_OBJECT",
+                1,
+                "NESTED_MESSAGE",
+                2,
+                "ARRAY_OF_TYPE",
+                3,
+                "ARRAY_OF_OBJECT",
+                4,
+                "ARRAY_OF_MESSAGE",
+                5
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ]
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "title": "PayloadService.Watch response",
+    "x-streaming": true
+}`
@@ -0,0 +1,53 @@
+package testdata
+
+const Thread = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "comments": {
+            "items": {
+                "$schema": "http://json-schema.org/draft-04/schema#",
+                "properties": {
+                    "text": {
+                        "type": "string"
+                    },
+                    "thread": {
+                        "$ref": "#/definitions/samples.Thread"
+                    }
+                },
+                "additionalProperties": true,
+                "type": "object",
+                "description": "Messages which refer to each other:"
+            },
+            "type": "array"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.Comment": {
+            "properties": {
+                "text": {
+                    "type": "string"
+                },
+                "thread": {
+                    "$ref": "#/definitions/samples.Thread"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "description": "Messages which refer to each other:"
+        },
+        "samples.Thread": {
+            "properties": {
+                "comments": {
+                    "items": {
+                        "$ref": "#/definitions/samples.Comment"
+                    },
+                    "type": "array"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        }
+    }
+}`
@@ -0,0 +1,37 @@
+package testdata
+
+const TreeNode = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "properties": {
+        "name": {
+            "type": "string"
+        },
+        "children": {
+            "items": {
+                "$ref": "#/definitions/samples.TreeNode"
+            },
+            "type": "array"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "definitions": {
+        "samples.TreeNode": {
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "children": {
+                    "items": {
+                        "$ref": "#/definitions/samples.TreeNode"
+                    },
+                    "type": "array"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object",
+            "description": "A message which refers directly to itself:"
+        }
+    },
+    "description": "A message which refers directly to itself:"
+}`
@@ -0,0 +1,340 @@
+package testdata
+
+const Validate = `{
+    "$schema": "http://json-schema.org/draft-04/schema#",
+    "required": [
+        "address"
+    ],
+    "properties": {
+        "name": {
+            "maxLength": 64,
+            "minLength": 1,
+            "type": "string"
+        },
+        "code": {
+            "pattern": "^[A-Z]{3}$",
+            "type": "string",
+            "allOf": [
+                {
+                    "pattern": "^X"
+                }
+            ]
+        },
+        "email": {
+            "type": "string",
+            "format": "email"
+        },
+        "id": {
+            "pattern": "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$",
+            "type": "string",
+            "format": "uuid"
+        },
+        "nickname": {
+            "type": "string",
+            "allOf": [
+                {
+                    "anyOf": [
+                        {
+                            "maxLength": 0
+                        },
+                        {
+                            "minLength": 3
+                        }
+                    ]
+                }
+            ]
+        },
+        "colour": {
+            "type": "string",
+            "not": {
+                "enum": [
+                    "red",
+                    "green"
+                ]
+            }
+        },
+        "age": {
+            "type": "integer",
+            "maximum": 150,
+            "minimum": 0
+        },
+        "ratio": {
+            "type": "number",
+            "exclusiveMaximum": true,
+            "exclusiveMinimum": true,
+            "maximum": 1,
+            "minimum": 0
+        },
+        "priority": {
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "type": "integer",
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "offset": {
+            "pattern": "^-?[0-9]+$",
+            "allOf": [
+                {
+                    "anyOf": [
+                        {
+                            "exclusiveMinimum": true,
+                            "minimum": 100
+                        },
+                        {
+                            "exclusiveMaximum": true,
+                            "maximum": -100
+                        }
+                    ]
+                }
+            ],
+            "oneOf": [
+                {
+                    "type": "integer"
+                },
+                {
+                    "type": "string"
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808
+        },
+        "status": {
+            "enum": [
+                "STATUS_UNSPECIFIED",
+                0,
+                "STATUS_ACTIVE",
+                1,
+                "STATUS_SUSPENDED",
+                2
+            ],
+            "oneOf": [
+                {
+                    "type": "string"
+                },
+                {
+                    "type": "integer"
+                }
+            ],
+            "not": {
+                "enum": [
+                    "STATUS_UNSPECIFIED",
+                    0
+                ]
+            }
+        },
+        "tags": {
+            "items": {
+                "minLength": 2,
+                "type": "string"
+            },
+            "maxItems": 10,
+            "minItems": 1,
+            "uniqueItems": true,
+            "type": "array"
+        },
+        "counts": {
+            "minProperties": 1,
+            "additionalProperties": {
+                "type": "integer",
+                "maximum": 2147483647,
+                "minimum": -2147483648
+            },
+            "type": "object",
+            "allOf": [
+                {
+                    "additionalProperties": {
+                        "minimum": 0
+                    }
+                }
+            ]
+        },
+        "address": {
+            "properties": {
+                "street": {
+                    "minLength": 1,
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "signature": {
+            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+            "type": "string",
+            "description": "Rules without a JSON-Schema equivalent are left out:",
+            "contentEncoding": "base64"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "Fields with protoc-gen-validate rules:"
+}`
+
+const ValidateDraft202012 = `{
+    "$schema": "https://json-schema.org/draft/2020-12/schema",
+    "required": [
+        "address"
+    ],
+    "properties": {
+        "name": {
+            "maxLength": 64,
+            "minLength": 1,
+            "type": "string"
+        },
+        "code": {
+            "pattern": "^[A-Z]{3}$",
+            "type": "string",
+            "allOf": [
+                {
+                    "pattern": "^X"
+                }
+            ]
+        },
+        "email": {
+            "type": "string",
+            "format": "email"
+        },
+        "id": {
+            "pattern": "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$",
+            "type": "string",
+            "format": "uuid"
+        },
+        "nickname": {
+            "type": "string",
+            "allOf": [
+                {
+                    "anyOf": [
+                        {
+                            "maxLength": 0
+                        },
+                        {
+                            "minLength": 3
+                        }
+                    ]
+                }
+            ]
+        },
+        "colour": {
+            "type": "string",
+            "not": {
+                "enum": [
+                    "red",
+                    "green"
+                ]
+            }
+        },
+        "age": {
+            "type": "integer",
+            "maximum": 150,
+            "minimum": 0
+        },
+        "ratio": {
+            "type": "number",
+            "exclusiveMaximum": 1,
+            "exclusiveMinimum": 0
+        },
+        "priority": {
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "type": "integer",
+            "maximum": 4294967295,
+            "minimum": 0
+        },
+        "offset": {
+            "pattern": "^-?[0-9]+$",
+            "allOf": [
+                {
+                    "anyOf": [
+                        {
+                            "exclusiveMinimum": 100
+                        },
+                        {
+                            "exclusiveMaximum": -100
+                        }
+                    ]
+                }
+            ],
+            "maximum": 9223372036854775807,
+            "minimum": -9223372036854775808,
+            "type": [
+                "integer",
+                "string"
+            ]
+        },
+        "status": {
+            "enum": [
+                "STATUS_UNSPECIFIED",
+                0,
+                "STATUS_ACTIVE",
+                1,
+                "STATUS_SUSPENDED",
+                2
+            ],
+            "not": {
+                "enum": [
+                    "STATUS_UNSPECIFIED",
+                    0
+                ]
+            },
+            "type": [
+                "string",
+                "integer"
+            ]
+        },
+        "tags": {
+            "items": {
+                "minLength": 2,
+                "type": "string"
+            },
+            "maxItems": 10,
+            "minItems": 1,
+            "uniqueItems": true,
+            "type": "array"
+        },
+        "counts": {
+            "minProperties": 1,
+            "additionalProperties": {
+                "type": "integer",
+                "maximum": 2147483647,
+                "minimum": -2147483648
+            },
+            "type": "object",
+            "allOf": [
+                {
+                    "additionalProperties": {
+                        "minimum": 0
+                    }
+                }
+            ],
+            "propertyNames": {
+                "pattern": "^x-"
+            }
+        },
+        "address": {
+            "properties": {
+                "street": {
+                    "minLength": 1,
+                    "type": "string"
+                }
+            },
+            "additionalProperties": true,
+            "type": "object"
+        },
+        "signature": {
+            "pattern": "^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$",
+            "type": "string",
+            "description": "Rules without a JSON-Schema equivalent are left out:",
+            "contentEncoding": "base64"
+        }
+    },
+    "additionalProperties": true,
+    "type": "object",
+    "description": "Fields with protoc-gen-validate rules:"
+}`
@@ -3,6 +3,7 @@ package converter
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/alecthomas/jsonschema"
@@ -12,46 +13,93 @@ import (
 	"github.com/xeipuuv/gojsonschema"
 )
 
+// Patterns for the strings which proto3 JSON uses to encode some types:
+const (
+	// Bytes are base64 (standard or URL-safe, with or without padding):
+	base64Pattern = `^(([A-Za-z0-9+/]{4})*([A-Za-z0-9+/]{2}(==)?|[A-Za-z0-9+/]{3}=?)?|([A-Za-z0-9_-]{4})*([A-Za-z0-9_-]{2}(==)?|[A-Za-z0-9_-]{3}=?)?)$`
+
+	// Durations are seconds, with up to nine fractional digits:
+	durationPattern = `^-?[0-9]+(\.[0-9]{1,9})?s$`
+
+	// 64-bit integers are decimal strings:
+	int64Pattern  = `^-?[0-9]+$`
+	uint64Pattern = `^[0-9]+$`
+
+	// Timestamps are RFC 3339, with up to nine fractional digits:
+	timestampPattern = `^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]{1,9})?(Z|[+-]([01][0-9]|2[0-3]):[0-5][0-9])$`
+)
+
 var (
 	globalPkg = &ProtoPackage{
 		name:     "",
 		parent:   nil,
 		children: make(map[string]*ProtoPackage),
 		types:    make(map[string]*descriptor.DescriptorProto),
+		enums:    make(map[string]*descriptor.EnumDescriptorProto),
 	}
 
 	wellKnownTypes = map[string]*jsonschema.Type{
 		// Simple WKTs
 		"BoolValue":   &jsonschema.Type{Type: gojsonschema.TYPE_BOOLEAN},
-		"BytesValue":  &jsonschema.Type{Type: gojsonschema.TYPE_STRING},
 		"DoubleValue": &jsonschema.Type{Type: gojsonschema.TYPE_NUMBER},
 		"FloatValue":  &jsonschema.Type{Type: gojsonschema.TYPE_NUMBER},
-		"Int32Value":  &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER},
-		"Int64Value":  &jsonschema.Type{Type: gojsonschema.TYPE_STRING},
-		"ListValue":   &jsonschema.Type{Type: gojsonschema.TYPE_ARRAY},
+		"Int64Value":  &jsonschema.Type{Type: gojsonschema.TYPE_STRING, Pattern: int64Pattern},
 		"StringValue": &jsonschema.Type{Type: gojsonschema.TYPE_STRING},
-		"Struct":      &jsonschema.Type{Type: gojsonschema.TYPE_OBJECT},
-		"UInt32Value": &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER},
-		"UInt64Value": &jsonschema.Type{Type: gojsonschema.TYPE_STRING},
+		"UInt64Value": &jsonschema.Type{Type: gojsonschema.TYPE_STRING, Pattern: uint64Pattern},
 
 		// Complex WKTs
+		"BytesValue": &jsonschema.Type{
+			Type:    gojsonschema.TYPE_STRING,
+			Pattern: base64Pattern,
+			Extras:  map[string]interface{}{"contentEncoding": "base64"},
+		},
 		"Duration": &jsonschema.Type{
 			Type:    gojsonschema.TYPE_STRING,
-			Format:  "regex",
-			Pattern: `^-?[0-9]+(\.[0-9]{0,9})?s$`,
+			Pattern: durationPattern,
 		},
 		"Empty": &jsonschema.Type{
 			Type:                 gojsonschema.TYPE_OBJECT,
 			AdditionalProperties: json.RawMessage("false"),
 		},
+		"FieldMask": &jsonschema.Type{
+			Type:    gojsonschema.TYPE_STRING,
+			Pattern: `^([a-z][a-zA-Z0-9]*(\.[a-z][a-zA-Z0-9]*)*(,[a-z][a-zA-Z0-9]*(\.[a-z][a-zA-Z0-9]*)*)*)?$`,
+		},
+		"Int32Value": &jsonschema.Type{
+			Type:   gojsonschema.TYPE_INTEGER,
+			Extras: map[string]interface{}{"minimum": math.MinInt32, "maximum": math.MaxInt32},
+		},
 		"Timestamp": &jsonschema.Type{
-			Type:   gojsonschema.TYPE_STRING,
-			Format: "date-time",
+			Type:    gojsonschema.TYPE_STRING,
+			Format:  "date-time",
+			Pattern: timestampPattern,
+		},
+		"UInt32Value": &jsonschema.Type{
+			Type:   gojsonschema.TYPE_INTEGER,
+			Extras: map[string]interface{}{"minimum": 0, "maximum": uint32(math.MaxUint32)},
 		},
 	}
 )
 
 func (c *Converter) registerType(pkgName *string, msg *descriptor.DescriptorProto) {
+	pkg := c.registerPackage(pkgName)
+	pkg.types[msg.GetName()] = msg
+}
+
+func (c *Converter) registerEnum(pkgName *string, enum *descriptor.EnumDescriptorProto) {
+	pkg := c.registerPackage(pkgName)
+	pkg.enums[enum.GetName()] = enum
+}
+
+// Remembers which file declared a message (and its nested messages):
+func (c *Converter) registerMessageFile(file *descriptor.FileDescriptorProto, msg *descriptor.DescriptorProto) {
+	c.messageFiles[msg] = file
+	for _, nested := range msg.GetNestedType() {
+		c.registerMessageFile(file, nested)
+	}
+}
+
+func (c *Converter) registerPackage(pkgName *string) *ProtoPackage {
 	pkg := globalPkg
 	if pkgName != nil {
 		for _, node := range strings.Split(*pkgName, ".") {
@@ -66,13 +114,14 @@ func (c *Converter) registerType(pkgName *string, msg *descriptor.DescriptorProt
 					parent:   pkg,
 					children: make(map[string]*ProtoPackage),
 					types:    make(map[string]*descriptor.DescriptorProto),
+					enums:    make(map[string]*descriptor.EnumDescriptorProto),
 				}
 				pkg.children[node] = child
 			}
 			pkg = child
 		}
 	}
-	pkg.types[msg.GetName()] = msg
+	return pkg
 }
 
 func (c *Converter) relativelyLookupNestedType(desc *descriptor.DescriptorProto, name string) (*descriptor.DescriptorProto, bool) {
@@ -104,97 +153,98 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 
 	// Switch the types, and pick a JSONSchema equivalent:
 	switch desc.GetType() {
-	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
-		descriptor.FieldDescriptorProto_TYPE_FLOAT:
-		if c.AllowNullValues {
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_NUMBER},
-			}
-		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_NUMBER
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		c.setNumberTypes(jsonSchemaType, gojsonschema.TYPE_NUMBER, protojsonDoublePattern)
+
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		c.setNumberTypes(jsonSchemaType, gojsonschema.TYPE_NUMBER, protojsonFloatPattern)
+		if c.EnforceFloat32Range {
+			setRange(jsonSchemaType, -math.MaxFloat32, math.MaxFloat32)
 		}
 
 	case descriptor.FieldDescriptorProto_TYPE_INT32,
-		descriptor.FieldDescriptorProto_TYPE_UINT32,
-		descriptor.FieldDescriptorProto_TYPE_FIXED32,
 		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
 		descriptor.FieldDescriptorProto_TYPE_SINT32:
-		if c.AllowNullValues {
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_INTEGER},
-			}
-		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_INTEGER
-		}
+		c.setNumberTypes(jsonSchemaType, gojsonschema.TYPE_INTEGER, protojsonInt32Pattern)
+		setRange(jsonSchemaType, math.MinInt32, math.MaxInt32)
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		c.setNumberTypes(jsonSchemaType, gojsonschema.TYPE_INTEGER, protojsonUint32Pattern)
+		setRange(jsonSchemaType, 0, uint32(math.MaxUint32))
 
 	case descriptor.FieldDescriptorProto_TYPE_INT64,
 		descriptor.FieldDescriptorProto_TYPE_UINT64,
 		descriptor.FieldDescriptorProto_TYPE_FIXED64,
 		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
 		descriptor.FieldDescriptorProto_TYPE_SINT64:
-		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER})
-		if !c.DisallowBigIntsAsStrings {
-			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_STRING})
+		unsigned := desc.GetType() == descriptor.FieldDescriptorProto_TYPE_UINT64 || desc.GetType() == descriptor.FieldDescriptorProto_TYPE_FIXED64
+		jsonTypes := []string{gojsonschema.TYPE_INTEGER}
+		switch {
+		case c.ProtojsonCompat:
+			jsonTypes = append(jsonTypes, gojsonschema.TYPE_STRING)
+			jsonSchemaType.Pattern = protojsonInt64Pattern
+			if unsigned {
+				jsonSchemaType.Pattern = protojsonUint64Pattern
+			}
+		case !c.DisallowBigIntsAsStrings:
+			jsonTypes = append(jsonTypes, gojsonschema.TYPE_STRING)
+			jsonSchemaType.Pattern = int64Pattern
+			if unsigned {
+				jsonSchemaType.Pattern = uint64Pattern
+			}
 		}
 		if c.AllowNullValues {
-			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_NULL})
+			jsonTypes = append(jsonTypes, gojsonschema.TYPE_NULL)
 		}
+		c.setTypes(jsonSchemaType, jsonTypes...)
 
-	case descriptor.FieldDescriptorProto_TYPE_STRING,
-		descriptor.FieldDescriptorProto_TYPE_BYTES:
-		if c.AllowNullValues {
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_STRING},
-			}
+		// The range only constrains numbers (strings are covered by the pattern):
+		if unsigned {
+			setRange(jsonSchemaType, 0, uint64(math.MaxUint64))
 		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_STRING
+			setRange(jsonSchemaType, math.MinInt64, math.MaxInt64)
 		}
 
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		c.setTypes(jsonSchemaType, c.withNull(gojsonschema.TYPE_STRING)...)
+
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		c.setTypes(jsonSchemaType, c.withNull(gojsonschema.TYPE_STRING)...)
+		jsonSchemaType.Pattern = base64Pattern
+		setExtra(jsonSchemaType, "contentEncoding", "base64")
+
 	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		if name, ok := strings.CutPrefix(desc.GetTypeName(), ".google.protobuf."); ok {
-			switch name {
-			case "NullValue":
-				return &jsonschema.Type{Type: gojsonschema.TYPE_NULL}, nil
-			default:
-				return nil, fmt.Errorf("unknown WKT enum: %s", name)
-			}
+		// NullValue is the only well-known ENUM with a JSON representation of its own:
+		if desc.GetTypeName() == ".google.protobuf.NullValue" {
+			return &jsonschema.Type{Type: gojsonschema.TYPE_NULL}, nil
 		}
 
-		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_STRING})
-		jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_INTEGER})
-		if c.AllowNullValues {
-			jsonSchemaType.OneOf = append(jsonSchemaType.OneOf, &jsonschema.Type{Type: gojsonschema.TYPE_NULL})
+		// Find the ENUM wherever it was declared (top-level, nested in a message, or imported):
+		enumDescriptor, ok := c.lookupEnum(curPkg, desc.GetTypeName())
+		if !ok {
+			return nil, fmt.Errorf("no such enum type named %s", desc.GetTypeName())
 		}
 
-		// Go through all the enums we have, see if we can match any to this field by name:
-		for _, enumDescriptor := range msg.GetEnumType() {
-
-			// Each one has several values:
-			for _, enumValue := range enumDescriptor.Value {
-
-				// Figure out the entire name of this field:
-				fullFieldName := fmt.Sprintf(".%v.%v", *msg.Name, *enumDescriptor.Name)
-
-				// If we find ENUM values for this field then put them into the JSONSchema list of allowed ENUM values:
-				if strings.HasSuffix(desc.GetTypeName(), fullFieldName) {
-					jsonSchemaType.Enum = append(jsonSchemaType.Enum, enumValue.Name)
-					jsonSchemaType.Enum = append(jsonSchemaType.Enum, enumValue.Number)
-				}
+		// OpenAPI documents refer to the ENUM's own schema (which may also allow NULL values here):
+		if c.generatesOpenAPI() {
+			refJSONSchemaType, err := c.enumDefinitionRef(enumDescriptor, desc.GetTypeName())
+			if err != nil {
+				return nil, err
+			}
+			if c.AllowNullValues {
+				jsonSchemaType.OneOf = []*jsonschema.Type{{Type: gojsonschema.TYPE_NULL}, refJSONSchemaType}
+			} else {
+				jsonSchemaType.Ref = refJSONSchemaType.Ref
 			}
+			break
 		}
 
+		// Put its values into the JSONSchema list of allowed ENUM values:
+		c.setEnumValues(jsonSchemaType, enumDescriptor, c.AllowNullValues)
+
 	case descriptor.FieldDescriptorProto_TYPE_BOOL:
-		if c.AllowNullValues {
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_BOOLEAN},
-			}
-		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_BOOLEAN
-		}
+		c.setTypes(jsonSchemaType, c.withNull(gojsonschema.TYPE_BOOLEAN)...)
 
 	case descriptor.FieldDescriptorProto_TYPE_GROUP,
 		descriptor.FieldDescriptorProto_TYPE_MESSAGE:
@@ -210,28 +260,19 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 		return nil, fmt.Errorf("unrecognized field type: %s", desc.GetType().String())
 	}
 
-	// Recurse array of primitive types:
+	// Recurse array of primitive types (everything but the description describes the items):
 	if desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED && jsonSchemaType.Type != gojsonschema.TYPE_OBJECT {
-		jsonSchemaType.Items = &jsonschema.Type{}
-
-		if len(jsonSchemaType.Enum) > 0 {
-			jsonSchemaType.Items.Enum = jsonSchemaType.Enum
-			jsonSchemaType.Enum = nil
-			jsonSchemaType.Items.OneOf = nil
-		} else {
-			jsonSchemaType.Items.Type = jsonSchemaType.Type
-			jsonSchemaType.Items.OneOf = jsonSchemaType.OneOf
+		itemsJSONSchemaType := *jsonSchemaType
+		itemsJSONSchemaType.Description = ""
+		if len(itemsJSONSchemaType.Enum) > 0 && !c.EnumOneOf {
+			itemsJSONSchemaType.OneOf = nil
 		}
 
-		if c.AllowNullValues {
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_NULL},
-				{Type: gojsonschema.TYPE_ARRAY},
-			}
-		} else {
-			jsonSchemaType.Type = gojsonschema.TYPE_ARRAY
-			jsonSchemaType.OneOf = []*jsonschema.Type{}
+		jsonSchemaType = &jsonschema.Type{
+			Description: jsonSchemaType.Description,
+			Items:       &itemsJSONSchemaType,
 		}
+		c.setTypes(jsonSchemaType, c.withNull(gojsonschema.TYPE_ARRAY)...)
 
 		return jsonSchemaType, nil
 	}
@@ -244,6 +285,25 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			return nil, fmt.Errorf("no such message type named %s", desc.GetTypeName())
 		}
 
+		// Reference messages from the shared definitions instead of inlining them (optionally, or when they are recursive):
+		if (c.UseRefs || c.messagesInProgress[recordType]) && !recordType.Options.GetMapEntry() && !isSpecialWellKnownType(pkgName, recordType) {
+			refJSONSchemaType, err := c.messageDefinitionRef(curPkg, recordType, pkgName, desc.GetTypeName())
+			if err != nil {
+				return nil, err
+			}
+
+			// Arrays hold the reference in their items:
+			if desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+				jsonSchemaType.Items = refJSONSchemaType
+				c.setTypes(jsonSchemaType, c.withNull(gojsonschema.TYPE_ARRAY)...)
+				return jsonSchemaType, nil
+			}
+
+			// Objects are just the reference (the definition takes care of NULL values):
+			refJSONSchemaType.Description = jsonSchemaType.Description
+			return refJSONSchemaType, nil
+		}
+
 		// Recurse the recordType:
 		recursedJSONSchemaType, err := c.convertMessageType(curPkg, recordType, pkgName)
 
@@ -273,29 +333,37 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 			}
 			jsonSchemaType.AdditionalProperties = additionalPropertiesJSON
 
+			// Constrain the keys according to their proto type:
+			if keyJSONSchemaType := mapKeyJSONSchemaType(recordType.GetField()[0]); keyJSONSchemaType != nil {
+				c.setMapKeys(jsonSchemaType, keyJSONSchemaType, value.(*jsonschema.Type))
+			}
+
 		// Arrays:
 		case desc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED:
 			jsonSchemaType.Items = recursedJSONSchemaType
 			jsonSchemaType.Type = gojsonschema.TYPE_ARRAY
 
-		case pkgName == ".google.protobuf":
-			jsonSchemaType.Type = recursedJSONSchemaType.Type
-			jsonSchemaType.OneOf = recursedJSONSchemaType.OneOf
-			jsonSchemaType.AdditionalProperties = nil
-			return jsonSchemaType, nil
+		case isSpecialWellKnownType(pkgName, recordType):
+			wellKnownJSONSchemaType := *recursedJSONSchemaType
+			wellKnownJSONSchemaType.Description = jsonSchemaType.Description
+			return &wellKnownJSONSchemaType, nil
 
-		// Objects:
+		// Objects are the whole schema of their message (its oneofs, required fields and options included), described
+		// by the field:
 		default:
-			jsonSchemaType.Properties = recursedJSONSchemaType.Properties
+			objectJSONSchemaType := *recursedJSONSchemaType
+			objectJSONSchemaType.Version = ""
+			objectJSONSchemaType.Description = jsonSchemaType.Description
+			objectJSONSchemaType.Extras = make(map[string]interface{})
+			for keyword, value := range recursedJSONSchemaType.Extras {
+				objectJSONSchemaType.Extras[keyword] = value
+			}
+			return &objectJSONSchemaType, nil
 		}
 
 		// Optionally allow NULL values, if not already nullable
 		if c.AllowNullValues && jsonSchemaType.OneOf == nil {
-			jsonSchemaType.OneOf = []*jsonschema.Type{
-				{Type: gojsonschema.TYPE_NULL},
-				{Type: jsonSchemaType.Type},
-			}
-			jsonSchemaType.Type = ""
+			c.setTypes(jsonSchemaType, c.withNull(jsonSchemaType.Type)...)
 		}
 	}
 
@@ -304,38 +372,22 @@ func (c *Converter) convertField(curPkg *ProtoPackage, desc *descriptor.FieldDes
 
 // Converts a proto "MESSAGE" into a JSON-Schema:
 func (c *Converter) convertMessageType(curPkg *ProtoPackage, msg *descriptor.DescriptorProto, pkgName string) (*jsonschema.Type, error) {
-	if pkgName == ".google.protobuf" {
-		name := msg.GetName()
-
-		if jsonType := wellKnownTypes[name]; jsonType != nil {
-			return &jsonschema.Type{
-				OneOf: []*jsonschema.Type{
-					{Type: gojsonschema.TYPE_NULL},
-					jsonType,
-				},
-			}, nil
-		}
-
-		switch name {
-		case "Value":
-			return &jsonschema.Type{
-				OneOf: []*jsonschema.Type{
-					{Type: gojsonschema.TYPE_NULL},
-					{Type: gojsonschema.TYPE_ARRAY},
-					{Type: gojsonschema.TYPE_BOOLEAN},
-					{Type: gojsonschema.TYPE_NUMBER},
-					{Type: gojsonschema.TYPE_OBJECT},
-					{Type: gojsonschema.TYPE_STRING},
-				},
-			}, nil
-		}
-
-		return nil, fmt.Errorf("unknown WKT message: %s", name)
+	// Well-known types can be NULL (which clears them):
+	if isSpecialWellKnownType(pkgName, msg) {
+		wellKnownJSONSchemaType, err := c.convertWellKnownType(msg.GetName())
+		if err != nil {
+			return nil, err
+		}
+		return c.nullable(wellKnownJSONSchemaType), nil
 	}
 
+	// Keep track of the messages we're in the middle of converting, so recursive references can be detected:
+	c.messagesInProgress[msg] = true
+	defer delete(c.messagesInProgress, msg)
+
 	// Prepare a new jsonschema:
 	jsonSchemaType := &jsonschema.Type{
-		Version: jsonschema.Version,
+		Version: c.schemaVersion(),
 	}
 
 	// Generate a description from src comments (if available)
@@ -344,30 +396,51 @@ func (c *Converter) convertMessageType(curPkg *ProtoPackage, msg *descriptor.Des
 	}
 
 	// Optionally allow NULL values:
-	if c.AllowNullValues {
-		jsonSchemaType.OneOf = []*jsonschema.Type{
-			{Type: gojsonschema.TYPE_NULL},
-			{Type: gojsonschema.TYPE_OBJECT},
-		}
-	} else {
-		jsonSchemaType.Type = gojsonschema.TYPE_OBJECT
-	}
+	c.setTypes(jsonSchemaType, c.withNull(gojsonschema.TYPE_OBJECT)...)
 
 	// disallowAdditionalProperties will prevent validation where extra fields are found (outside of the schema):
-	if c.DisallowAdditionalProperties {
-		jsonSchemaType.AdditionalProperties = []byte("false")
-	} else {
-		jsonSchemaType.AdditionalProperties = []byte("true")
+	c.setAdditionalProperties(jsonSchemaType, c.allowsAdditionalProperties(msg))
+
+	setDeprecated(jsonSchemaType, msg.GetOptions().GetDeprecated())
+
+	// Apply any overrides from the message's options:
+	if err := applyMessageOptions(msg, jsonSchemaType); err != nil {
+		return nil, err
 	}
 
 	c.logger.WithField("message_str", proto.MarshalTextString(msg)).Trace("Converting message")
 	for _, fieldDesc := range msg.GetField() {
+		if c.excludesField(fieldDesc) {
+			c.logger.WithField("field_name", fieldDesc.GetName()).WithField("message_name", msg.GetName()).Debug("Ignoring field")
+			continue
+		}
+
 		recursedJSONSchemaType, err := c.convertField(curPkg, fieldDesc, msg)
 		if err != nil {
 			c.logger.WithError(err).WithField("field_name", fieldDesc.GetName()).WithField("message_name", msg.GetName()).Error("Failed to convert field")
 			return jsonSchemaType, err
 		}
 		c.logger.WithField("field_name", fieldDesc.GetName()).WithField("type", recursedJSONSchemaType.Type).Debug("Converted field")
+
+		// Translate any protoc-gen-validate rules:
+		if err := c.applyValidationRules(curPkg, msg, fieldDesc, recursedJSONSchemaType); err != nil {
+			c.logger.WithError(err).WithField("field_name", fieldDesc.GetName()).WithField("message_name", msg.GetName()).Error("Failed to apply validation rules")
+			return jsonSchemaType, err
+		}
+
+		// Proto3 "optional" fields can always be NULL (unless we're already allowing NULL values everywhere):
+		if fieldDesc.GetProto3Optional() && !c.AllowNullValues {
+			recursedJSONSchemaType = c.nullable(recursedJSONSchemaType)
+		}
+
+		// Mark fields which only go one way (before the field's options, which can override them):
+		c.applyFieldBehavior(fieldDesc, recursedJSONSchemaType)
+		setDeprecated(recursedJSONSchemaType, fieldDesc.GetOptions().GetDeprecated())
+
+		// Apply any overrides from the field's options:
+		if err := c.applyFieldOptions(curPkg, fieldDesc, recursedJSONSchemaType); err != nil {
+			return jsonSchemaType, err
+		}
 		if jsonSchemaType.Properties == nil {
 			jsonSchemaType.Properties = orderedmap.New()
 		}
@@ -375,11 +448,163 @@ func (c *Converter) convertMessageType(curPkg *ProtoPackage, msg *descriptor.Des
 		if c.UseProtoAndJSONFieldnames && fieldDesc.GetName() != fieldDesc.GetJsonName() {
 			jsonSchemaType.Properties.Set(fieldDesc.GetJsonName(), recursedJSONSchemaType)
 		}
+
+		// Required fields must be present under one of their names:
+		if c.isRequiredField(msg, fieldDesc) {
+			if c.UseProtoAndJSONFieldnames && fieldDesc.GetName() != fieldDesc.GetJsonName() {
+				jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{
+					AnyOf: []*jsonschema.Type{
+						{Required: []string{fieldDesc.GetName()}},
+						{Required: []string{fieldDesc.GetJsonName()}},
+					},
+				})
+			} else {
+				jsonSchemaType.Required = append(jsonSchemaType.Required, fieldDesc.GetName())
+			}
+		}
+	}
+
+	// Allow at most one member of each "oneof" to be set:
+	for oneofIndex, oneofDesc := range msg.GetOneofDecl() {
+		if oneofJSONSchemaType := c.convertOneof(msg, int32(oneofIndex), oneofDesc); oneofJSONSchemaType != nil {
+			jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, oneofJSONSchemaType)
+		}
 	}
 
 	return jsonSchemaType, nil
 }
 
+// Sets the range of numbers which fit into a proto scalar type (these are extras, because jsonschema.Type can't express
+// a minimum of zero or the largest uint64s):
+func setRange(jsonSchemaType *jsonschema.Type, minimum, maximum interface{}) {
+	setExtra(jsonSchemaType, "minimum", minimum)
+	setExtra(jsonSchemaType, "maximum", maximum)
+}
+
+// Tells whether a message is one of the well-known types with a JSON representation of their own (the rest of them,
+// like google.protobuf.Api or google.protobuf.Type, are just ordinary messages):
+func isSpecialWellKnownType(pkgName string, msg *descriptor.DescriptorProto) bool {
+	if pkgName != ".google.protobuf" {
+		return false
+	}
+
+	switch msg.GetName() {
+	case "Any", "ListValue", "Struct", "Value":
+		return true
+	default:
+		return wellKnownTypes[msg.GetName()] != nil
+	}
+}
+
+// Decides whether a field has to be present in the JSON representation of its message:
+func (c *Converter) isRequiredField(msg *descriptor.DescriptorProto, fieldDesc *descriptor.FieldDescriptorProto) bool {
+	if fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED || c.hasRequiredValidationRule(fieldDesc) || getFieldOptions(fieldDesc).GetRequired() || c.hasFieldBehavior(fieldDesc, fieldBehaviorRequired) {
+		return true
+	}
+
+	// Messages (or files) can require all of their fields, except for the members of oneofs (which exclude each other):
+	if c.requiresAllFields(msg) && (fieldDesc.OneofIndex == nil || fieldDesc.GetProto3Optional()) {
+		return true
+	}
+
+	// Optionally require proto3 fields which are always emitted (because they can't tell "unset" from "default"):
+	if c.RequireImplicitPresenceFields && c.messageFiles[msg].GetSyntax() == "proto3" {
+		return !hasExplicitPresence(fieldDesc)
+	}
+
+	return false
+}
+
+// Converts a well-known type into the JSON-Schema of its JSON representation (which the caller makes nullable):
+func (c *Converter) convertWellKnownType(name string) (*jsonschema.Type, error) {
+	if jsonType := c.wellKnownType(name); jsonType != nil {
+		return jsonType, nil
+	}
+
+	switch name {
+	case "Any":
+		return c.convertAny()
+
+	case "ListValue", "Struct":
+		return c.structDefinitionRef("google.protobuf." + name)
+
+	// Values can already be NULL:
+	case "Value":
+		return c.structDefinitionRef(valueDefinitionName)
+	}
+
+	return nil, fmt.Errorf("unknown WKT message: %s", name)
+}
+
+// Tells whether a field is left out of the schema of its message (because of its options, the variant we're
+// converting, or because it's deprecated):
+func (c *Converter) excludesField(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	return getFieldOptions(fieldDesc).GetIgnore() || c.excludedByFieldBehavior(fieldDesc) || c.excludedAsDeprecated(fieldDesc)
+}
+
+// Tells whether a proto3 field tracks presence (ie whether it can be "unset" rather than just its default value):
+func hasExplicitPresence(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	switch {
+	case fieldDesc.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED:
+		return false
+	case fieldDesc.GetProto3Optional(), fieldDesc.OneofIndex != nil:
+		return true
+	case fieldDesc.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE,
+		fieldDesc.GetType() == descriptor.FieldDescriptorProto_TYPE_GROUP:
+		return true
+	default:
+		return false
+	}
+}
+
+// Converts a proto "ONEOF" into a constraint which rejects objects setting more than one of its members:
+func (c *Converter) convertOneof(msg *descriptor.DescriptorProto, oneofIndex int32, oneofDesc *descriptor.OneofDescriptorProto) *jsonschema.Type {
+
+	// Gather the property names of each member (a member may be known by both its proto and JSON name):
+	var memberNames [][]string
+	for _, fieldDesc := range msg.GetField() {
+		if fieldDesc.OneofIndex == nil || fieldDesc.GetOneofIndex() != oneofIndex {
+			continue
+		}
+
+		// Proto3 "optional" fields live in synthetic oneofs of their own, which aren't real choices:
+		if fieldDesc.GetProto3Optional() || c.excludesField(fieldDesc) {
+			continue
+		}
+		names := []string{fieldDesc.GetName()}
+		if c.UseProtoAndJSONFieldnames && fieldDesc.GetName() != fieldDesc.GetJsonName() {
+			names = append(names, fieldDesc.GetJsonName())
+		}
+		memberNames = append(memberNames, names)
+	}
+
+	// Any pair of properties from two different members is forbidden:
+	var forbiddenPairs []*jsonschema.Type
+	for i := range memberNames {
+		for j := i + 1; j < len(memberNames); j++ {
+			for _, first := range memberNames[i] {
+				for _, second := range memberNames[j] {
+					forbiddenPairs = append(forbiddenPairs, &jsonschema.Type{Required: []string{first, second}})
+				}
+			}
+		}
+	}
+	if len(forbiddenPairs) == 0 {
+		return nil
+	}
+
+	oneofJSONSchemaType := &jsonschema.Type{
+		Not: &jsonschema.Type{AnyOf: forbiddenPairs},
+	}
+
+	// Generate a description from src comments (if available)
+	if src := c.sourceInfo.GetOneof(oneofDesc); src != nil {
+		oneofJSONSchemaType.Description = formatDescription(src)
+	}
+
+	return oneofJSONSchemaType
+}
+
 func formatDescription(sl *descriptor.SourceCodeInfo_Location) string {
 	var lines []string
 	for _, str := range sl.GetLeadingDetachedComments() {
@@ -0,0 +1,531 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/alecthomas/jsonschema"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/iancoleman/orderedmap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The field option which protoc-gen-validate keeps its rules in:
+const validateRulesExtension = "validate.rules"
+
+// Patterns for the strings which protoc-gen-validate checks without a JSON-Schema "format" of its own:
+const (
+	uuidPattern = `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+
+	// HTTP header names and values (RFC 7230), and what's left of them when "strict" is off:
+	httpHeaderNamePattern     = "^:?[0-9a-zA-Z!#$%&'*+-.^_|~`]+$"
+	httpHeaderValuePattern    = `^[^\x00-\x08\x0A-\x1F\x7F]*$`
+	httpHeaderLoosePattern    = `^[^\x00\x0A\x0D]*$`
+	knownRegexHTTPHeaderName  = 1
+	knownRegexHTTPHeaderValue = 2
+)
+
+// Returns the protoc-gen-validate rules of a field (if it has any):
+func (c *Converter) validationRules(fieldDesc *descriptor.FieldDescriptorProto) (protoreflect.Message, bool) {
+	rules, ok := c.options.extension(fieldDesc.GetOptions(), validateRulesExtension)
+	if !ok {
+		return nil, false
+	}
+	return rules.Message(), true
+}
+
+// Tells whether protoc-gen-validate requires a (message) field to be set:
+func (c *Converter) hasRequiredValidationRule(fieldDesc *descriptor.FieldDescriptorProto) bool {
+	rules, ok := c.validationRules(fieldDesc)
+	if !ok {
+		return false
+	}
+	if messageRules, ok := ruleValue(rules, "message"); ok {
+		if required, ok := ruleValue(messageRules.Message(), "required"); ok && required.Bool() {
+			return true
+		}
+	}
+	if typeField := rules.WhichOneof(rules.Descriptor().Oneofs().ByName("type")); typeField != nil {
+		if required, ok := ruleValue(rules.Get(typeField).Message(), "required"); ok {
+			return required.Bool()
+		}
+	}
+	return false
+}
+
+// Translates the protoc-gen-validate rules of a field into the equivalent JSON-Schema keywords:
+func (c *Converter) applyValidationRules(curPkg *ProtoPackage, msg *descriptor.DescriptorProto, fieldDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type) error {
+	rules, ok := c.validationRules(fieldDesc)
+	if !ok {
+		return nil
+	}
+	return c.applyFieldRules(curPkg, msg.GetName()+"."+fieldDesc.GetName(), fieldDesc, jsonSchemaType, rules)
+}
+
+// Applies one set of rules (for a field, the items of a repeated field, or the keys / values of a map) to a schema:
+func (c *Converter) applyFieldRules(curPkg *ProtoPackage, fieldName string, valueDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, rules protoreflect.Message) error {
+	if messageRules, ok := ruleValue(rules, "message"); ok {
+		if skip, ok := ruleValue(messageRules.Message(), "skip"); ok && skip.Bool() {
+			c.warnUnsupportedRule(fieldName, "message.skip")
+		}
+	}
+
+	typeField := rules.WhichOneof(rules.Descriptor().Oneofs().ByName("type"))
+	if typeField == nil {
+		return nil
+	}
+	ruleType := string(typeField.Name())
+	typeRules := rules.Get(typeField).Message()
+
+	// Rules which ignore empty values only have to hold for anything else:
+	target := jsonSchemaType
+	if ignoreEmpty, ok := ruleValue(typeRules, "ignore_empty"); ok && ignoreEmpty.Bool() {
+		target = &jsonschema.Type{}
+		jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{
+			AnyOf: []*jsonschema.Type{c.emptyValueJSONSchemaType(valueDesc, ruleType), target},
+		})
+	}
+
+	switch ruleType {
+	case "bool":
+		rangeRules(typeRules, func(name string, value protoreflect.Value) {
+			switch name {
+			case "const":
+				c.setConst(target, value.Bool())
+			default:
+				c.warnUnsupportedRule(fieldName, ruleType+"."+name)
+			}
+		})
+
+	case "double", "float", "int32", "int64", "uint32", "uint64", "sint32", "sint64", "fixed32", "fixed64", "sfixed32", "sfixed64":
+		c.applyNumberRules(fieldName, ruleType, valueDesc, target, typeRules)
+
+	case "string":
+		c.applyStringRules(fieldName, target, typeRules)
+
+	case "enum":
+		return c.applyEnumRules(curPkg, fieldName, valueDesc, target, typeRules)
+
+	case "repeated":
+		return c.applyRepeatedRules(curPkg, fieldName, valueDesc, target, typeRules)
+
+	case "map":
+		return c.applyMapRules(curPkg, fieldName, valueDesc, target, typeRules)
+
+	case "any":
+		rangeRules(typeRules, func(name string, value protoreflect.Value) {
+			switch name {
+			case "required":
+			case "in":
+				typeURLs := orderedmap.New()
+				typeURLs.Set(anyTypeProperty, &jsonschema.Type{Enum: listValues(value.List())})
+				target.AllOf = append(target.AllOf, &jsonschema.Type{Properties: typeURLs})
+			case "not_in":
+				typeURLs := orderedmap.New()
+				typeURLs.Set(anyTypeProperty, &jsonschema.Type{Not: &jsonschema.Type{Enum: listValues(value.List())}})
+				target.AllOf = append(target.AllOf, &jsonschema.Type{Properties: typeURLs})
+			default:
+				c.warnUnsupportedRule(fieldName, ruleType+"."+name)
+			}
+		})
+
+	// Bytes are base64 encoded (so their lengths and contents can't be checked), and durations and timestamps are
+	// strings (which can't be compared):
+	default:
+		rangeRules(typeRules, func(name string, value protoreflect.Value) {
+			switch name {
+			case "required", "ignore_empty":
+			default:
+				c.warnUnsupportedRule(fieldName, ruleType+"."+name)
+			}
+		})
+	}
+
+	return nil
+}
+
+// Numbers can be constrained to a range, or to some values. Fields which take numbers as strings too only allow the
+// (canonical) strings of their values, but JSON-Schema can't compare strings with a range:
+func (c *Converter) applyNumberRules(fieldName, ruleType string, valueDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, typeRules protoreflect.Message) {
+	var lower, upper protoreflect.Value
+	var lowerExclusive, upperExclusive bool
+	rangeRules(typeRules, func(name string, value protoreflect.Value) {
+		switch name {
+		case "const":
+			c.setConst(jsonSchemaType, c.numberValues(valueDesc, value)...)
+		case "lt", "lte":
+			upper, upperExclusive = value, name == "lt"
+			c.warnStringRangeRule(fieldName, ruleType+"."+name, valueDesc)
+		case "gt", "gte":
+			lower, lowerExclusive = value, name == "gt"
+			c.warnStringRangeRule(fieldName, ruleType+"."+name, valueDesc)
+		case "in":
+			jsonSchemaType.Enum = c.numberListValues(valueDesc, value.List())
+		case "not_in":
+			addNot(jsonSchemaType, &jsonschema.Type{Enum: c.numberListValues(valueDesc, value.List())})
+		case "ignore_empty":
+		default:
+			c.warnUnsupportedRule(fieldName, ruleType+"."+name)
+		}
+	})
+
+	// An upper bound below the lower bound means that the numbers have to be outside of the range:
+	if lower.IsValid() && upper.IsValid() && floatValue(upper) < floatValue(lower) {
+		lowerJSONSchemaType, upperJSONSchemaType := &jsonschema.Type{}, &jsonschema.Type{}
+		c.setMinimum(lowerJSONSchemaType, jsonNumber(lower), lowerExclusive)
+		c.setMaximum(upperJSONSchemaType, jsonNumber(upper), upperExclusive)
+		jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{
+			AnyOf: []*jsonschema.Type{lowerJSONSchemaType, upperJSONSchemaType},
+		})
+		return
+	}
+	if lower.IsValid() {
+		c.setMinimum(jsonSchemaType, jsonNumber(lower), lowerExclusive)
+	}
+	if upper.IsValid() {
+		c.setMaximum(jsonSchemaType, jsonNumber(upper), upperExclusive)
+	}
+}
+
+// Strings have lengths, patterns and formats (the lengths in bytes are the only thing JSON-Schema can't count):
+func (c *Converter) applyStringRules(fieldName string, jsonSchemaType *jsonschema.Type, typeRules protoreflect.Message) {
+	rangeRules(typeRules, func(name string, value protoreflect.Value) {
+		switch name {
+		case "const":
+			c.setConst(jsonSchemaType, value.String())
+		case "len":
+			setLimit(jsonSchemaType, &jsonSchemaType.MinLength, "minLength", value.Uint())
+			setLimit(jsonSchemaType, &jsonSchemaType.MaxLength, "maxLength", value.Uint())
+		case "min_len":
+			setLimit(jsonSchemaType, &jsonSchemaType.MinLength, "minLength", value.Uint())
+		case "max_len":
+			setLimit(jsonSchemaType, &jsonSchemaType.MaxLength, "maxLength", value.Uint())
+		case "pattern":
+			addPattern(jsonSchemaType, value.String())
+		case "prefix":
+			addPattern(jsonSchemaType, "^"+regexp.QuoteMeta(value.String()))
+		case "suffix":
+			addPattern(jsonSchemaType, regexp.QuoteMeta(value.String())+"$")
+		case "contains":
+			addPattern(jsonSchemaType, regexp.QuoteMeta(value.String()))
+		case "not_contains":
+			addNot(jsonSchemaType, &jsonschema.Type{Pattern: regexp.QuoteMeta(value.String())})
+		case "in":
+			jsonSchemaType.Enum = listValues(value.List())
+		case "not_in":
+			addNot(jsonSchemaType, &jsonschema.Type{Enum: listValues(value.List())})
+		case "email", "hostname", "ipv4", "ipv6":
+			jsonSchemaType.Format = name
+		case "uri":
+			jsonSchemaType.Format = "uri"
+		case "uri_ref":
+			jsonSchemaType.Format = "uri-reference"
+		case "uuid":
+			jsonSchemaType.Format = "uuid"
+			addPattern(jsonSchemaType, uuidPattern)
+		case "ip":
+			jsonSchemaType.AnyOf = []*jsonschema.Type{{Format: "ipv4"}, {Format: "ipv6"}}
+		case "address":
+			jsonSchemaType.AnyOf = []*jsonschema.Type{{Format: "hostname"}, {Format: "ipv4"}, {Format: "ipv6"}}
+		case "well_known_regex":
+			strict := typeRules.Get(typeRules.Descriptor().Fields().ByName("strict")).Bool()
+			switch value.Enum() {
+			case knownRegexHTTPHeaderName:
+				if strict {
+					addPattern(jsonSchemaType, httpHeaderNamePattern)
+				} else {
+					addPattern(jsonSchemaType, httpHeaderLoosePattern)
+				}
+			case knownRegexHTTPHeaderValue:
+				if strict {
+					addPattern(jsonSchemaType, httpHeaderValuePattern)
+				} else {
+					addPattern(jsonSchemaType, httpHeaderLoosePattern)
+				}
+			}
+		case "strict", "ignore_empty":
+		default:
+			c.warnUnsupportedRule(fieldName, "string."+name)
+		}
+	})
+}
+
+// ENUMs can be constrained to some of their values (which are only allowed in the configured encodings):
+func (c *Converter) applyEnumRules(curPkg *ProtoPackage, fieldName string, valueDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, typeRules protoreflect.Message) error {
+	enum, ok := c.lookupEnum(curPkg, valueDesc.GetTypeName())
+	if !ok {
+		return fmt.Errorf("no such enum type named %s", valueDesc.GetTypeName())
+	}
+
+	rangeRules(typeRules, func(name string, value protoreflect.Value) {
+		switch name {
+		case "const":
+			constJSONSchemaType := &jsonschema.Type{}
+			c.setConst(constJSONSchemaType, c.enumRuleValues(enum, []int32{int32(value.Int())})...)
+			jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, constJSONSchemaType)
+		case "in":
+			jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{Enum: c.enumRuleValues(enum, enumNumbers(value.List()))})
+		case "not_in":
+			addNot(jsonSchemaType, &jsonschema.Type{Enum: c.enumRuleValues(enum, enumNumbers(value.List()))})
+
+		// The schema only allows the defined values anyway:
+		case "defined_only":
+		default:
+			c.warnUnsupportedRule(fieldName, "enum."+name)
+		}
+	})
+	return nil
+}
+
+// Repeated fields have a number of items, which have rules of their own:
+func (c *Converter) applyRepeatedRules(curPkg *ProtoPackage, fieldName string, valueDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, typeRules protoreflect.Message) error {
+	var err error
+	rangeRules(typeRules, func(name string, value protoreflect.Value) {
+		switch name {
+		case "min_items":
+			setLimit(jsonSchemaType, &jsonSchemaType.MinItems, "minItems", value.Uint())
+		case "max_items":
+			setLimit(jsonSchemaType, &jsonSchemaType.MaxItems, "maxItems", value.Uint())
+		case "unique":
+			jsonSchemaType.UniqueItems = value.Bool()
+		case "items":
+			if jsonSchemaType.Items == nil {
+				jsonSchemaType.Items = &jsonschema.Type{}
+			}
+			if itemsErr := c.applyFieldRules(curPkg, fieldName, valueDesc, jsonSchemaType.Items, value.Message()); itemsErr != nil {
+				err = itemsErr
+			}
+		case "ignore_empty":
+		default:
+			c.warnUnsupportedRule(fieldName, "repeated."+name)
+		}
+	})
+	return err
+}
+
+// Maps have a number of pairs, and their keys and values have rules of their own:
+func (c *Converter) applyMapRules(curPkg *ProtoPackage, fieldName string, valueDesc *descriptor.FieldDescriptorProto, jsonSchemaType *jsonschema.Type, typeRules protoreflect.Message) error {
+	mapEntry, _, ok := c.lookupType(curPkg, valueDesc.GetTypeName())
+	if !ok {
+		return fmt.Errorf("no such message type named %s", valueDesc.GetTypeName())
+	}
+	keyDesc, valueFieldDesc := mapEntry.GetField()[0], mapEntry.GetField()[1]
+
+	var err error
+	rangeRules(typeRules, func(name string, value protoreflect.Value) {
+		switch name {
+		case "min_pairs":
+			setLimit(jsonSchemaType, &jsonSchemaType.MinProperties, "minProperties", value.Uint())
+		case "max_pairs":
+			setLimit(jsonSchemaType, &jsonSchemaType.MaxProperties, "maxProperties", value.Uint())
+
+		// Keys are always strings in JSON, so only string rules still make sense for them:
+		case "keys":
+			if keyDesc.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING {
+				c.warnUnsupportedRule(fieldName, "map.keys")
+				return
+			}
+
+			// Which are constrained by "propertyNames" (there's nothing to apply their rules to before draft-06):
+			if !c.draftAtLeast(Draft06) {
+				c.warnUnsupportedRule(fieldName, "map.keys (before draft-06)")
+				return
+			}
+			keyJSONSchemaType, ok := jsonSchemaType.Extras["propertyNames"].(*jsonschema.Type)
+			if !ok {
+				keyJSONSchemaType = &jsonschema.Type{}
+				setExtra(jsonSchemaType, "propertyNames", keyJSONSchemaType)
+			}
+			if keysErr := c.applyFieldRules(curPkg, fieldName, keyDesc, keyJSONSchemaType, value.Message()); keysErr != nil {
+				err = keysErr
+			}
+
+		// The values are already described by "additionalProperties", which we can only add to with another one:
+		case "values":
+			valueJSONSchemaType := &jsonschema.Type{}
+			if valuesErr := c.applyFieldRules(curPkg, fieldName, valueFieldDesc, valueJSONSchemaType, value.Message()); valuesErr != nil {
+				err = valuesErr
+				return
+			}
+			valueJSON, marshalErr := json.Marshal(valueJSONSchemaType)
+			if marshalErr != nil {
+				err = marshalErr
+				return
+			}
+			jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{AdditionalProperties: valueJSON})
+		case "ignore_empty":
+		default:
+			c.warnUnsupportedRule(fieldName, "map."+name)
+		}
+	})
+	return err
+}
+
+// Describes the empty value of a type (which rules with "ignore_empty" don't apply to):
+func (c *Converter) emptyValueJSONSchemaType(valueDesc *descriptor.FieldDescriptorProto, ruleType string) *jsonschema.Type {
+	emptyJSONSchemaType := &jsonschema.Type{}
+	switch ruleType {
+	case "string", "bytes":
+		setExtra(emptyJSONSchemaType, "maxLength", 0)
+	case "repeated":
+		setExtra(emptyJSONSchemaType, "maxItems", 0)
+	case "map":
+		setExtra(emptyJSONSchemaType, "maxProperties", 0)
+	default:
+		c.setConst(emptyJSONSchemaType, c.numberValues(valueDesc, protoreflect.ValueOfInt64(0))...)
+	}
+	return emptyJSONSchemaType
+}
+
+// Returns the JSON encodings of an ENUM's values, in the configured encodings:
+func (c *Converter) enumRuleValues(enum *descriptor.EnumDescriptorProto, numbers []int32) []interface{} {
+	var values []interface{}
+	for _, number := range numbers {
+		for _, enumValue := range c.enumValues(enum) {
+			if enumValue.GetNumber() != number {
+				continue
+			}
+			if c.EnumsAs != EnumsAsNumbers {
+				values = append(values, enumValue.GetName())
+			}
+			if c.EnumsAs != EnumsAsNames {
+				values = append(values, enumValue.GetNumber())
+			}
+		}
+	}
+	return values
+}
+
+// Returns the JSON encodings of a number (along with the string which can stand for it, if the field takes strings):
+func (c *Converter) numberValues(valueDesc *descriptor.FieldDescriptorProto, value protoreflect.Value) []interface{} {
+	number := jsonNumber(value)
+	if c.acceptsNumberStrings(valueDesc) {
+		return []interface{}{number, fmt.Sprint(number)}
+	}
+	return []interface{}{number}
+}
+
+func (c *Converter) numberListValues(valueDesc *descriptor.FieldDescriptorProto, list protoreflect.List) []interface{} {
+	var values []interface{}
+	for i := 0; i < list.Len(); i++ {
+		values = append(values, c.numberValues(valueDesc, list.Get(i))...)
+	}
+	return values
+}
+
+// Tells whether numbers of a field may also be given as strings (see convertField):
+func (c *Converter) acceptsNumberStrings(valueDesc *descriptor.FieldDescriptorProto) bool {
+	switch valueDesc.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return c.ProtojsonCompat || !c.DisallowBigIntsAsStrings
+	default:
+		return c.ProtojsonCompat
+	}
+}
+
+func (c *Converter) warnUnsupportedRule(fieldName, rule string) {
+	c.logger.WithField("field_name", fieldName).WithField("rule", rule).Warn("Ignoring a validation rule which has no JSON-Schema equivalent")
+}
+
+// Ranges only apply to numbers, so the strings of fields which take numbers as strings too aren't kept within them:
+func (c *Converter) warnStringRangeRule(fieldName, rule string, valueDesc *descriptor.FieldDescriptorProto) {
+	if c.acceptsNumberStrings(valueDesc) {
+		c.warnUnsupportedRule(fieldName, rule+" (for numbers given as strings)")
+	}
+}
+
+// Calls f with each rule which is set, in the order they were declared in:
+func rangeRules(rules protoreflect.Message, f func(name string, value protoreflect.Value)) {
+	fields := rules.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if field := fields.Get(i); rules.Has(field) {
+			f(string(field.Name()), rules.Get(field))
+		}
+	}
+}
+
+// Returns a rule, if it is set:
+func ruleValue(rules protoreflect.Message, name string) (protoreflect.Value, bool) {
+	field := rules.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil || !rules.Has(field) {
+		return protoreflect.Value{}, false
+	}
+	return rules.Get(field), true
+}
+
+// Numbers as they should appear in JSON (float32s are widened to the float64 with the same decimal representation):
+func jsonNumber(value protoreflect.Value) interface{} {
+	if number, ok := value.Interface().(float32); ok {
+		widened, _ := strconv.ParseFloat(strconv.FormatFloat(float64(number), 'g', -1, 32), 64)
+		return widened
+	}
+	return value.Interface()
+}
+
+func floatValue(value protoreflect.Value) float64 {
+	switch number := value.Interface().(type) {
+	case int32:
+		return float64(number)
+	case int64:
+		return float64(number)
+	case uint32:
+		return float64(number)
+	case uint64:
+		return float64(number)
+	case float32:
+		return float64(number)
+	case float64:
+		return number
+	default:
+		return 0
+	}
+}
+
+func listValues(list protoreflect.List) []interface{} {
+	values := make([]interface{}, list.Len())
+	for i := range values {
+		values[i] = jsonNumber(list.Get(i))
+	}
+	return values
+}
+
+func enumNumbers(list protoreflect.List) []int32 {
+	numbers := make([]int32, list.Len())
+	for i := range numbers {
+		numbers[i] = int32(list.Get(i).Int())
+	}
+	return numbers
+}
+
+// Adds a pattern to a schema (which has to match as well as any pattern it already had):
+func addPattern(jsonSchemaType *jsonschema.Type, pattern string) {
+	if jsonSchemaType.Pattern == "" {
+		jsonSchemaType.Pattern = pattern
+		return
+	}
+	jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{Pattern: pattern})
+}
+
+// Forbids a schema (along with anything it already forbade):
+func addNot(jsonSchemaType *jsonschema.Type, not *jsonschema.Type) {
+	if jsonSchemaType.Not == nil {
+		jsonSchemaType.Not = not
+		return
+	}
+	jsonSchemaType.AllOf = append(jsonSchemaType.AllOf, &jsonschema.Type{Not: not})
+}
+
+// Sets a size limit (as an extra when it's zero, because jsonschema.Type would leave it out):
+func setLimit(jsonSchemaType *jsonschema.Type, field *int, keyword string, limit uint64) {
+	if limit == 0 {
+		setExtra(jsonSchemaType, keyword, 0)
+		return
+	}
+	*field = int(limit)
+}